@@ -0,0 +1,207 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+Bias is the side towards which Center places s when the padding
+can't be divided equally between both sides.
+*/
+type Bias int
+
+const (
+	// BiasLeft places the extra padding on the right.
+	BiasLeft Bias = iota
+
+	// BiasRight places the extra padding on the left.
+	BiasRight
+)
+
+/*
+Center pads both sides of s with padChar until s contains length
+number of runes. If the padding can't be divided equally the extra
+rune goes on the right. See CenterBias to change this.
+
+	s := str.Center("Hi", '-', 6)  // "--Hi--"
+	s := str.Center("Hi", '-', 5)  // "-Hi--"
+*/
+func Center(s string, padChar rune, length int) string {
+	return CenterBias(s, padChar, length, ByRunes, BiasLeft)
+}
+
+/*
+CenterWidth pads both sides of s with padChar until s is width
+columns wide when displayed in a monospace terminal. See Width for
+how columns are counted and PadLeftWidth for how wide padding
+characters are handled.
+
+	s := str.CenterWidth("世界", ' ', 8) // "  世界  "
+*/
+func CenterWidth(s string, padChar rune, width int) string {
+	return CenterBias(s, padChar, width, ByWidth, BiasLeft)
+}
+
+/*
+CenterBias pads both sides of s with padChar until s is length long
+measured in u. If the padding can't be divided equally the extra
+padding goes on the side opposite b.
+
+	s := str.CenterBias("Hi", '-', 5, str.ByRunes, str.BiasRight) // "--Hi-"
+*/
+func CenterBias(s string, padChar rune, length int, u Unit, b Bias) string {
+
+	diff := length - u.measure(s)
+	if diff <= 0 {
+		return s
+	}
+
+	left, right := diff/2, diff-diff/2
+	if b == BiasRight {
+		left, right = right, left
+	}
+
+	lpad, lrem := padUnits(left, padChar, u)
+	rpad, rrem := padUnits(right, padChar, u)
+	return lrem + lpad + s + rpad + rrem
+}
+
+/*
+padUnits is the same as padWidth except that diff is measured in u.
+Only ByWidth leaves a remainder.
+*/
+func padUnits(diff int, padChar rune, u Unit) (pad, rem string) {
+	if u == ByWidth {
+		return padWidth(diff, padChar)
+	}
+	if diff <= 0 {
+		return "", ""
+	}
+	return strings.Repeat(string(padChar), diff), ""
+}
+
+/*
+Justify widens each line in lines to length runes by adding spaces
+between its words, which are found as by Words. Spaces are only added
+where there are spaces already, so words joined by a slash or dash
+stay joined. Extra spaces are shared as evenly as possible, with any
+left over going to the leftmost gaps. Anything before the first word,
+such as indentation or an opening quote, is kept as it is.
+
+The last line of each paragraph, which is a line followed by an empty
+line or the end of lines, is left as it is, as are lines with fewer
+than two words and lines already too long to be justified. Justify
+modifies lines in place and returns it. Its input is typically the
+result of WrapLines; use JustifyWrapped if a prefix or indent was
+given to WrapLines.
+
+	lines := str.Justify(str.WrapLines(s, 20, str.WrapOptions{}), 20)
+*/
+func Justify(lines []string, length int) []string {
+	return justify(lines, length, WrapOptions{})
+}
+
+/*
+JustifyWidth is the same as Justify except that lines are widened to
+width columns when displayed in a monospace terminal. See Width for
+how columns are counted.
+*/
+func JustifyWidth(lines []string, width int) []string {
+	return justify(lines, width, WrapOptions{Unit: ByWidth})
+}
+
+/*
+JustifyWrapped is the same as Justify except that lines are measured
+in opts.Unit and opts.Prefix and the indents at the start of each
+line are kept as they are, so that the result of WrapLines may be
+justified with the same options. Lines consisting of just the prefix
+are empty.
+
+	opts := str.WrapOptions{Prefix: "> "}
+	lines := str.JustifyWrapped(str.WrapLines(s, 20, opts), 20, opts)
+*/
+func JustifyWrapped(lines []string, width int, opts WrapOptions) []string {
+	return justify(lines, width, opts)
+}
+
+func justify(lines []string, length int, opts WrapOptions) []string {
+
+	u := opts.Unit
+
+	// Blank lines of wrapped text hold the prefix less any
+	// trailing spaces.
+	blank := strings.TrimRightFunc(opts.Prefix, unicode.IsSpace)
+
+	// The longer indent is tried first in case the other is a
+	// prefix of it.
+	indents := []string{opts.FirstIndent, opts.HangingIndent}
+	if len(indents[1]) > len(indents[0]) {
+		indents[0], indents[1] = indents[1], indents[0]
+	}
+
+	for i, line := range lines {
+
+		if i == len(lines)-1 || strings.TrimSpace(strings.TrimPrefix(lines[i+1], blank)) == "" {
+			continue
+		}
+
+		rest := strings.TrimPrefix(line, opts.Prefix)
+		for _, indent := range indents {
+			if indent != "" && strings.HasPrefix(rest, indent) {
+				rest = rest[len(indent):]
+				break
+			}
+		}
+		lead := line[:len(line)-len(rest)]
+		rest = strings.TrimRightFunc(rest, unicode.IsSpace)
+
+		var words [][2]int
+		defaultTokenizer.scan(rest, func(start, end int) {
+			words = append(words, [2]int{start, end})
+		})
+
+		// pieces holds the text before each gap, which ends
+		// with the first run of spaces between two words, and
+		// the text after the last gap.
+		var pieces []string
+		from := 0
+		for j := 1; j < len(words); j++ {
+			sep := rest[words[j-1][1]:words[j][0]]
+			k := strings.IndexFunc(sep, unicode.IsSpace)
+			if k < 0 {
+				continue
+			}
+			k += len(sep[k:]) - len(strings.TrimLeftFunc(sep[k:], unicode.IsSpace))
+			end := words[j-1][1] + k
+			pieces = append(pieces, rest[from:end])
+			from = end
+		}
+		pieces = append(pieces, rest[from:])
+
+		gaps := len(pieces) - 1
+		if gaps < 1 {
+			continue
+		}
+		spaces := length - u.measure(lead) - u.measure(rest)
+		if spaces < 0 {
+			continue
+		}
+
+		var b strings.Builder
+		b.WriteString(lead)
+		for j, p := range pieces {
+			b.WriteString(p)
+			if j < gaps {
+				n := spaces / gaps
+				if j < spaces%gaps {
+					n++
+				}
+				b.WriteString(strings.Repeat(" ", n))
+			}
+		}
+		lines[i] = b.String()
+	}
+
+	return lines
+}
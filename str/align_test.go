@@ -0,0 +1,185 @@
+package str
+
+import "testing"
+
+func TestCenter(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		pad  rune
+		s    string
+		want string
+	}{
+		{6, '-', "Hi", "--Hi--"},
+		{5, '-', "Hi", "-Hi--"},
+		{2, '-', "Hi", "Hi"},
+		{-1, '-', "Hi", "Hi"},
+		{4, ' ', "", "    "},
+		{6, '*', "世界", "**世界**"},
+		{4, '世', "hi", "世hi世"},
+	}
+
+	for _, c := range cases {
+		if got := Center(c.s, c.pad, c.n); got != c.want {
+			t.Errorf("Center(%q, %q, %d) return %q, wanted %q.", c.s, c.pad, c.n, got, c.want)
+		}
+	}
+}
+
+func TestCenterWidth(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		pad  rune
+		s    string
+		want string
+	}{
+		{8, ' ', "世界", "  世界  "},
+		{7, ' ', "世界", " 世界  "},
+		{3, ' ', "世界", "世界"},
+		{8, '世', "hi", " 世hi世 "},
+		{7, '世', "hi", "世hi世 "},
+		{5, '́', "hi", "hi"},
+	}
+
+	for _, c := range cases {
+		if got := CenterWidth(c.s, c.pad, c.n); got != c.want {
+			t.Errorf("CenterWidth(%q, %q, %d) return %q, wanted %q.", c.s, c.pad, c.n, got, c.want)
+		}
+	}
+}
+
+func TestCenterBias(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		s    string
+		u    Unit
+		b    Bias
+		want string
+	}{
+		{5, "Hi", ByRunes, BiasLeft, "-Hi--"},
+		{5, "Hi", ByRunes, BiasRight, "--Hi-"},
+		{5, "café", ByRunes, BiasRight, "café"},
+		{5, "café", ByGraphemes, BiasRight, "-café"},
+		{7, "世界", ByWidth, BiasRight, "--世界-"},
+	}
+
+	for _, c := range cases {
+		if got := CenterBias(c.s, '-', c.n, c.u, c.b); got != c.want {
+			t.Errorf("CenterBias(%q, '-', %d, %d, %d) return %q, wanted %q.", c.s, c.n, c.u, c.b, got, c.want)
+		}
+	}
+}
+
+func TestJustify(t *testing.T) {
+
+	cases := []struct {
+		lines []string
+		n     int
+		want  []string
+	}{
+		{
+			[]string{"The quick brown", "fox jumps over", "the lazy dog."},
+			16,
+			[]string{"The  quick brown", "fox  jumps  over", "the lazy dog."},
+		},
+		{
+			[]string{"a b c", "d", "", "e  f", "g h"},
+			7,
+			[]string{"a  b  c", "d", "", "e     f", "g h"},
+		},
+		{
+			[]string{"  indented line", "end"},
+			17,
+			[]string{"  indented   line", "end"},
+		},
+		{
+			[]string{"either/or and this", "> quoted text", "end"},
+			20,
+			[]string{"either/or  and  this", "> quoted        text", "end"},
+		},
+		{
+			[]string{"word", "much too long", "end"},
+			8,
+			[]string{"word", "much too long", "end"},
+		},
+		{nil, 10, nil},
+	}
+
+	for _, c := range cases {
+		input := append([]string(nil), c.lines...)
+		if got := Justify(c.lines, c.n); !strSliceEqual(got, c.want) {
+			t.Errorf("Justify(%q, %d) return %s, wanted %s.", input, c.n, quoteSlice(got), quoteSlice(c.want))
+		}
+	}
+}
+
+func TestJustifyWidth(t *testing.T) {
+
+	lines := []string{"世界 世界 世界", "end"}
+	want := []string{"世界  世界  世界", "end"}
+
+	if got := JustifyWidth(lines, 16); !strSliceEqual(got, want) {
+		t.Errorf("JustifyWidth(lines, 16) return %s, wanted %s.", quoteSlice(got), quoteSlice(want))
+	}
+}
+
+func TestJustifyWrapped(t *testing.T) {
+
+	s := "The quick brown fox jumps over the lazy dog and more\n\nSecond para here now"
+
+	cases := []struct {
+		width int
+		opts  WrapOptions
+		want  []string
+	}{
+		{
+			22,
+			WrapOptions{Prefix: "Note: ", HangingIndent: "  "},
+			[]string{
+				"Note: The  quick brown",
+				"Note:   fox jumps over",
+				"Note:   the  lazy  dog",
+				"Note:   and more",
+				"Note:",
+				"Note: Second para here",
+				"Note:   now",
+			},
+		},
+		{
+			20,
+			WrapOptions{FirstIndent: "No. ", HangingIndent: "    "},
+			[]string{
+				"No. The  quick brown",
+				"    fox  jumps  over",
+				"    the lazy dog and",
+				"    more",
+				"No.",
+				"No. Second para here",
+				"    now",
+			},
+		},
+		{
+			16,
+			WrapOptions{Prefix: "世界 ", Unit: ByWidth},
+			[]string{
+				"世界 The   quick",
+				"世界 brown   fox",
+				"世界 jumps  over",
+				"世界 the    lazy",
+				"世界 dog     and",
+				"世界 more",
+				"世界",
+				"世界 Second para",
+				"世界 here now",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		if got := JustifyWrapped(WrapLines(s, c.width, c.opts), c.width, c.opts); !strSliceEqual(got, c.want) {
+			t.Errorf("JustifyWrapped(WrapLines(%q, %d, %+v)) return %s, wanted %s.", s, c.width, c.opts, quoteSlice(got), quoteSlice(c.want))
+		}
+	}
+}
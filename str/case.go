@@ -0,0 +1,493 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Caser changes the case of strings using the full case mappings of
+Unicode, under which one rune may become several: "ß" becomes "SS"
+in upper case and "ﬁ" becomes "Fi" in title case. A capital sigma
+becomes a final sigma, "ς", when lowercased at the end of a word.
+
+Lang is the ISO 639-1 code of the language whose rules are followed;
+a region may follow it, as in "tr-TR". The zero value follows rules
+common to all languages. The languages with rules of their own are:
+
+	tr, az  "i" and "ı" are distinct letters whose capitals are
+	        "İ" and "I".
+	lt      "i" and "j" keep their dot when lowercased beneath
+	        an accent, as in "i̇̀", and lose it when capitalised.
+	nl      "ij" is capitalised as a single letter, "IJ".
+	el      Upper case letters lose their accents, and a diaeresis
+	        is added where an accent kept two vowels apart, as in
+	        "άι", which becomes "ΑΪ".
+
+The package level functions ToUpper, ToLower, ToTitle, Capitalise and
+Fold use a Caser with no language.
+
+	s := str.Caser{Lang: "tr"}.ToUpper("istanbul")   // "İSTANBUL"
+	s := str.Caser{Lang: "nl"}.ToTitle("ijsselmeer") // "IJsselmeer"
+*/
+type Caser struct {
+	Lang string
+}
+
+/*
+ToUpper returns s with all its letters mapped to upper case.
+
+	s := str.ToUpper("Straße") // "STRASSE"
+*/
+func ToUpper(s string) string {
+	return Caser{}.ToUpper(s)
+}
+
+/*
+ToLower returns s with all its letters mapped to lower case.
+
+	s := str.ToLower("ΟΔΥΣΣΕΥΣ") // "οδυσσευς"
+*/
+func ToLower(s string) string {
+	return Caser{}.ToLower(s)
+}
+
+/*
+ToTitle returns s with the first letter of each word mapped to title
+case and the rest of the word to lower case. Words are found as by
+UnicodeWords. Unlike strings.ToTitle, which maps every letter to title
+case, it produces the kind of capitalisation used in titles.
+
+	s := str.ToTitle("the DJANGO unchained") // "The Django Unchained"
+	s := str.ToTitle("ǆungla")               // "ǅungla"
+*/
+func ToTitle(s string) string {
+	return Caser{}.ToTitle(s)
+}
+
+/*
+Fold returns s with full Unicode case folding applied. Strings that
+differ only in case fold to the same string, so Fold is suited to
+case insensitive comparisons. Folded text is mostly lower case but
+not intended for display.
+
+	f := str.Fold("Straße") // "strasse"
+	f := str.Fold("ΣΊΣΥΦΟΣ") == str.Fold("σίσυφος") // true
+*/
+func Fold(s string) string {
+	return Caser{}.Fold(s)
+}
+
+/*
+ToUpper returns s with all its letters mapped to upper case according
+to the rules of c's language.
+*/
+func (c Caser) ToUpper(s string) string {
+
+	if isASCII(s) && !c.turkic() {
+		return strings.ToUpper(s)
+	}
+
+	rr := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	c.writeUpper(&b, rr, 0, len(rr))
+
+	if c.lang() == "el" {
+		return removeGreekAccents(b.String())
+	}
+	return b.String()
+}
+
+/*
+ToLower returns s with all its letters mapped to lower case according
+to the rules of c's language.
+*/
+func (c Caser) ToLower(s string) string {
+
+	if isASCII(s) && !c.turkic() {
+		return strings.ToLower(s)
+	}
+
+	rr := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	c.writeLower(&b, rr, 0, len(rr))
+	return b.String()
+}
+
+/*
+ToTitle returns s with the first letter of each word mapped to title
+case and the rest of the word to lower case according to the rules of
+c's language. See the package level ToTitle.
+*/
+func (c Caser) ToTitle(s string) string {
+
+	rr := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+
+	i := 0
+	forEachWordSegment(s, func(seg string) {
+		n := utf8.RuneCountInString(seg)
+		if unicode.IsLetter(rr[i]) {
+			c.writeCapital(&b, rr, i, i+n, true)
+		} else {
+			c.writeLower(&b, rr, i, i+n)
+		}
+		i += n
+	})
+
+	return b.String()
+}
+
+/*
+Capitalise returns s with its first rune mapped to title case
+according to the rules of c's language. The rest of s is unchanged.
+See the package level Capitalise.
+*/
+func (c Caser) Capitalise(s string) string {
+	rr := []rune(s)
+	if len(rr) == 0 {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	c.writeCapital(&b, rr, 0, len(rr), false)
+	return b.String()
+}
+
+/*
+Fold returns s with full Unicode case folding applied. Under Turkish
+and Azeri rules "I" folds to "ı" and "İ" to "i". See the package level
+Fold.
+*/
+func (c Caser) Fold(s string) string {
+
+	if isASCII(s) && !c.turkic() {
+		return strings.ToLower(s)
+	}
+
+	turkic := c.turkic()
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range s {
+		switch {
+		case turkic && r == 'I':
+			b.WriteRune('ı')
+		case turkic && r == 'İ':
+			b.WriteRune('i')
+		default:
+			if f, ok := caseFolding[r]; ok {
+				b.WriteString(f)
+			} else {
+				b.WriteRune(unicode.ToLower(r))
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// lang returns c's language code without any region.
+func (c Caser) lang() string {
+	lang := strings.ToLower(c.Lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+func (c Caser) turkic() bool {
+	lang := c.lang()
+	return lang == "tr" || lang == "az"
+}
+
+/*
+writeUpper writes rr[lo:hi] mapped to upper case to b. The rest of rr
+provides the context for conditional mappings.
+*/
+func (c Caser) writeUpper(b *strings.Builder, rr []rune, lo, hi int) {
+
+	turkic, lt := c.turkic(), c.lang() == "lt"
+
+	for i := lo; i < hi; i++ {
+		r := rr[i]
+		switch {
+		case turkic && r == 'i':
+			b.WriteRune('İ')
+		case lt && r == 0x307 && afterSoftDotted(rr, i):
+		default:
+			if u, ok := upperSpecial[r]; ok {
+				b.WriteString(u)
+			} else {
+				b.WriteRune(unicode.ToUpper(r))
+			}
+		}
+	}
+}
+
+/*
+writeLower writes rr[lo:hi] mapped to lower case to b. The rest of rr
+provides the context for conditional mappings.
+*/
+func (c Caser) writeLower(b *strings.Builder, rr []rune, lo, hi int) {
+
+	turkic, lt := c.turkic(), c.lang() == "lt"
+
+	for i := lo; i < hi; i++ {
+
+		r := rr[i]
+
+		if turkic {
+			switch {
+			case r == 'İ':
+				b.WriteRune('i')
+				continue
+			case r == 'I' && beforeDot(rr, i):
+				b.WriteRune('i')
+				continue
+			case r == 'I':
+				b.WriteRune('ı')
+				continue
+			case r == 0x307 && afterI(rr, i):
+				continue
+			}
+		}
+
+		if lt {
+			if l, ok := lithuanianLower[r]; ok {
+				b.WriteString(l)
+				continue
+			}
+			if (r == 'I' || r == 'J' || r == 'Į') && moreAbove(rr, i) {
+				b.WriteRune(unicode.ToLower(r))
+				b.WriteRune(0x307)
+				continue
+			}
+		}
+
+		switch {
+		case r == 'Σ' && finalSigma(rr, i):
+			b.WriteRune('ς')
+		default:
+			if l, ok := lowerSpecial[r]; ok {
+				b.WriteString(l)
+			} else {
+				b.WriteRune(unicode.ToLower(r))
+			}
+		}
+	}
+}
+
+/*
+writeCapital writes rr[lo:hi] to b with its first rune mapped to title
+case and, if lower is true, the rest mapped to lower case.
+*/
+func (c Caser) writeCapital(b *strings.Builder, rr []rune, lo, hi int, lower bool) {
+
+	part := func(from, to int) {
+		if lower {
+			c.writeLower(b, rr, from, to)
+		} else {
+			b.WriteString(string(rr[from:to]))
+		}
+	}
+
+	r := rr[lo]
+	switch lang := c.lang(); {
+	case lang == "nl" && hi-lo > 1 && (r == 'i' || r == 'I') && (rr[lo+1] == 'j' || rr[lo+1] == 'J'):
+		b.WriteString("IJ")
+		part(lo+2, hi)
+		return
+	case c.turkic() && r == 'i':
+		b.WriteRune('İ')
+	case lang == "lt" && unicode.Is(unicode.Soft_Dotted, r):
+		// The letter loses any dot above that follows it.
+		b.WriteRune(unicode.ToTitle(r))
+		for i := lo + 1; i < hi && combiningClass(rr[i]) != 0; i++ {
+			if rr[i] == 0x307 && afterSoftDotted(rr, i) {
+				part(lo+1, i)
+				part(i+1, hi)
+				return
+			}
+		}
+	default:
+		if t, ok := titleSpecial[r]; ok {
+			b.WriteString(t)
+		} else {
+			b.WriteRune(unicode.ToTitle(r))
+		}
+	}
+	part(lo+1, hi)
+}
+
+// lithuanianLower holds the Lithuanian lower case mappings of accented
+// capitals, which keep the dot of the "i".
+var lithuanianLower = map[rune]string{
+	'\u00cc': "i\u0307\u0300",
+	'\u00cd': "i\u0307\u0301",
+	'\u0128': "i\u0307\u0303",
+}
+
+/*
+afterSoftDotted reports whether rr[i] follows a soft dotted letter,
+such as "i", with no intervening starter or mark above.
+*/
+func afterSoftDotted(rr []rune, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		if unicode.Is(unicode.Soft_Dotted, rr[j]) {
+			return true
+		}
+		if cc := combiningClass(rr[j]); cc == 0 || cc == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+/*
+afterI reports whether rr[i] follows an "I" with no intervening
+starter or mark above.
+*/
+func afterI(rr []rune, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		if rr[j] == 'I' {
+			return true
+		}
+		if cc := combiningClass(rr[j]); cc == 0 || cc == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+/*
+beforeDot reports whether rr[i] is followed by a combining dot above
+with no intervening starter or other mark above.
+*/
+func beforeDot(rr []rune, i int) bool {
+	for j := i + 1; j < len(rr); j++ {
+		if rr[j] == 0x307 {
+			return true
+		}
+		if cc := combiningClass(rr[j]); cc == 0 || cc == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+/*
+moreAbove reports whether rr[i] is followed by a mark above with no
+intervening starter.
+*/
+func moreAbove(rr []rune, i int) bool {
+	for j := i + 1; j < len(rr); j++ {
+		switch combiningClass(rr[j]) {
+		case 230:
+			return true
+		case 0:
+			return false
+		}
+	}
+	return false
+}
+
+/*
+finalSigma reports whether the capital sigma rr[i] ends a word, which
+it does if it follows a cased letter and isn't followed by one.
+Case ignorable runes such as apostrophes and marks are skipped.
+*/
+func finalSigma(rr []rune, i int) bool {
+
+	j := i - 1
+	for j >= 0 && !isCased(rr[j]) && isCaseIgnorable(rr[j]) {
+		j--
+	}
+	if j < 0 || !isCased(rr[j]) {
+		return false
+	}
+
+	k := i + 1
+	for k < len(rr) && isCaseIgnorable(rr[k]) {
+		k++
+	}
+	return k == len(rr) || !isCased(rr[k])
+}
+
+func isCased(r rune) bool {
+	return unicode.In(r, unicode.Lu, unicode.Ll, unicode.Lt, unicode.Other_Lowercase, unicode.Other_Uppercase)
+}
+
+func isCaseIgnorable(r rune) bool {
+	return strings.ContainsRune("'.:·\u0387\u055f\u05f4‘’․‧︓﹒﹕＇．：", r) ||
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk)
+}
+
+// greekAccents holds the combining marks that Greek capitals lose.
+const greekAccents = "\u0300\u0301\u0313\u0314\u0342\u0343"
+
+/*
+removeGreekAccents returns s, which is in upper case, with accents
+removed from Greek letters. Diaereses are kept, and one is added to
+an "Ι" or "Υ" that follows a vowel with an acute, grave or
+circumflex accent, as the accent showed that the two are not a
+diphthong: "άι" becomes "ΑΪ". Breathing marks are not counted. Only Greek
+letters and their marks are normalized; the rest of s is unchanged.
+*/
+func removeGreekAccents(s string) string {
+
+	var b strings.Builder
+	b.Grow(len(s))
+	afterAccent := false
+
+	for s != "" {
+
+		// Each Greek letter is handled along with the
+		// combining marks that follow it.
+		r, n := utf8.DecodeRuneInString(s)
+		for n < len(s) {
+			m, size := utf8.DecodeRuneInString(s[n:])
+			if combiningClass(m) == 0 {
+				break
+			}
+			n += size
+		}
+		letter := s[:n]
+		s = s[n:]
+
+		if !unicode.Is(unicode.Greek, r) {
+			b.WriteString(letter)
+			afterAccent = false
+			continue
+		}
+
+		rr, _ := normalizeRunes(letter, NFD)
+		kept := rr[:1]
+		accent, diaeresis := false, false
+		for _, m := range rr[1:] {
+			if strings.ContainsRune(greekAccents, m) {
+				accent = accent || m != '\u0313' && m != '\u0314'
+				continue
+			}
+			if m == '\u0308' {
+				diaeresis = true
+			}
+			kept = append(kept, m)
+		}
+
+		vowel := strings.ContainsRune("ΑΕΗΙΟΥΩ", rr[0])
+		if afterAccent && !diaeresis && (rr[0] == 'Ι' || rr[0] == 'Υ') {
+			kept = append(kept, '\u0308')
+			diaeresis = true
+		}
+		afterAccent = vowel && accent && !diaeresis
+
+		b.WriteString(Normalize(string(kept), NFC))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,169 @@
+package str
+
+import "testing"
+
+func TestToUpper(t *testing.T) {
+
+	cases := []struct {
+		lang string
+		s    string
+		want string
+	}{
+		{"", "hello", "HELLO"},
+		{"", "Straße", "STRASSE"},
+		{"", "ﬁne", "FINE"},
+		{"", "istanbul", "ISTANBUL"},
+		{"tr", "istanbul", "İSTANBUL"},
+		{"tr-TR", "ırmak", "IRMAK"},
+		{"az", "iı", "İI"},
+		{"el", "Οδυσσέας", "ΟΔΥΣΣΕΑΣ"},
+		{"el", "άυλος", "ΑΫΛΟΣ"},
+		{"el", "άι", "ΑΪ"},
+		{"el", "Μαΐου", "ΜΑΪΟΥ"},
+		{"el", "τρόλεϊ", "ΤΡΟΛΕΪ"},
+		{"el", "προϊόν", "ΠΡΟΪΟΝ"},
+		{"el", "αύριο", "ΑΥΡΙΟ"},
+		{"el", "ἄι ἀί", "ΑΪ ΑΙ"},
+		{"el", "e\u0301 ά", "E\u0301 Α"},
+		{"", "Οδυσσέας", "ΟΔΥΣΣΈΑΣ"},
+		{"lt", "i\u0307\u0301", "I\u0301"},
+		{"", "i\u0307", "I\u0307"},
+		{"", "", ""},
+	}
+
+	for _, c := range cases {
+		if got := (Caser{Lang: c.lang}).ToUpper(c.s); got != c.want {
+			t.Errorf("Caser{%q}.ToUpper(%q) return %q, wanted %q.", c.lang, c.s, got, c.want)
+		}
+	}
+}
+
+func TestToLower(t *testing.T) {
+
+	cases := []struct {
+		lang string
+		s    string
+		want string
+	}{
+		{"", "HELLO", "hello"},
+		{"", "ΟΔΥΣΣΕΥΣ", "οδυσσευς"},
+		{"", "ΣΑΣ ΣΑΣ.", "σας σας."},
+		{"", "Σ", "σ"},
+		{"", "İ", "i\u0307"},
+		{"", "ISTANBUL", "istanbul"},
+		{"tr", "ISTANBUL", "ıstanbul"},
+		{"tr", "İstanbul", "istanbul"},
+		{"tr", "I\u0307", "i"},
+		{"lt", "Ì", "i\u0307\u0300"},
+		{"lt", "I\u0301", "i\u0307\u0301"},
+		{"lt", "IS", "is"},
+		{"", "", ""},
+	}
+
+	for _, c := range cases {
+		if got := (Caser{Lang: c.lang}).ToLower(c.s); got != c.want {
+			t.Errorf("Caser{%q}.ToLower(%q) return %q, wanted %q.", c.lang, c.s, got, c.want)
+		}
+	}
+}
+
+func TestToTitle(t *testing.T) {
+
+	cases := []struct {
+		lang string
+		s    string
+		want string
+	}{
+		{"", "the DJANGO unchained", "The Django Unchained"},
+		{"", "ǆungla", "ǅungla"},
+		{"", "ﬁsh and chips", "Fish And Chips"},
+		{"", "o'neill's 2nd visit", "O'neill's 2nd Visit"},
+		{"", "ijsselmeer", "Ijsselmeer"},
+		{"nl", "ijsselmeer", "IJsselmeer"},
+		{"nl", "IJSSELMEER", "IJsselmeer"},
+		{"tr", "izmir istanbul", "İzmir İstanbul"},
+		{"", "ΟΔΥΣΣΕΥΣ", "Οδυσσευς"},
+		{"", "", ""},
+	}
+
+	for _, c := range cases {
+		if got := (Caser{Lang: c.lang}).ToTitle(c.s); got != c.want {
+			t.Errorf("Caser{%q}.ToTitle(%q) return %q, wanted %q.", c.lang, c.s, got, c.want)
+		}
+	}
+}
+
+func TestCaserCapitalise(t *testing.T) {
+
+	cases := []struct {
+		lang string
+		s    string
+		want string
+	}{
+		{"", "hello WORLD", "Hello WORLD"},
+		{"", "ǆ", "ǅ"},
+		{"", "ßa", "Ssa"},
+		{"tr", "istanbul", "İstanbul"},
+		{"nl", "ijs", "IJs"},
+		{"lt", "i\u0307\u0301s", "I\u0301s"},
+		{"", "", ""},
+	}
+
+	for _, c := range cases {
+		if got := (Caser{Lang: c.lang}).Capitalise(c.s); got != c.want {
+			t.Errorf("Caser{%q}.Capitalise(%q) return %q, wanted %q.", c.lang, c.s, got, c.want)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+
+	cases := []struct {
+		lang string
+		s    string
+		want string
+	}{
+		{"", "Hello", "hello"},
+		{"", "Straße", "strasse"},
+		{"", "ΣΊΣΥΦΟΣ", "σίσυφοσ"},
+		{"", "σίσυφος", "σίσυφοσ"},
+		{"", "\u212a", "k"}, // Kelvin sign
+		{"", "ﬁ", "fi"},
+		{"", "İ", "i\u0307"},
+		{"", "I", "i"},
+		{"tr", "I", "ı"},
+		{"tr", "İ", "i"},
+		{"", "", ""},
+	}
+
+	for _, c := range cases {
+		if got := (Caser{Lang: c.lang}).Fold(c.s); got != c.want {
+			t.Errorf("Caser{%q}.Fold(%q) return %q, wanted %q.", c.lang, c.s, got, c.want)
+		}
+	}
+}
+
+func TestFoldFlags(t *testing.T) {
+
+	got := WordSet("Straße STRASSE strasse", true)
+	want := []string{"strasse"}
+	if !strSliceEqual(got, want) {
+		t.Errorf("WordSet fold return %s, wanted %s.", quoteSlice(got), quoteSlice(want))
+	}
+
+	got = CharSet("Σσς", true)
+	want = []string{"σ"}
+	if !strSliceEqual(got, want) {
+		t.Errorf("CharSet fold return %s, wanted %s.", quoteSlice(got), quoteSlice(want))
+	}
+
+	c := NewCounter(true)
+	c.AddWords("ΟΔΥΣΣΕΥΣ and Οδυσσεύς and οδυσσευς")
+	if n := c.Get("ΟΔΥΣΣΕΥΣ"); n != 2 {
+		t.Errorf("Counter.Get return %d, wanted 2.", n)
+	}
+
+	if d := Levenshtein("ΣΑΣ", "σας", true); d != 0 {
+		t.Errorf("Levenshtein fold return %d, wanted 0.", d)
+	}
+}
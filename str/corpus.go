@@ -0,0 +1,216 @@
+package str
+
+import (
+	"context"
+	"hash/maphash"
+	"io"
+	"runtime"
+	"sync"
+)
+
+/*
+ParallelWordsByOccurrence counts the words in each of rr using up to
+workers goroutines and returns an unordered OccMap of the combined
+counts. The result contains the same occurrences as calling
+WordsByOccurrence on the concatenation of every reader's content with
+a space between each, but the readers are streamed rather than held
+in memory. See WordScanner for how words are read; a word longer
+than bufio.MaxScanTokenSize stops counting with bufio.ErrTooLong.
+
+If workers is less than 1 runtime.GOMAXPROCS(0) is used. The fold
+parameter behaves as it does for WordsByOccurrence.
+
+Counting stops early if ctx is cancelled, in which case ctx's error
+is returned, or if reading from any of rr fails, in which case the
+first such error is returned. The OccMap is nil whenever the error
+is non-nil.
+*/
+func ParallelWordsByOccurrence(ctx context.Context, rr []io.Reader, workers int, fold bool) (OccMap, error) {
+	return parallelCount(ctx, workers, fold, func(yield func(countJob) bool) {
+		for _, r := range rr {
+			if !yield(readerJob(r)) {
+				return
+			}
+		}
+	})
+}
+
+/*
+ParallelWordsByOccurrenceSeq is the same as ParallelWordsByOccurrence
+except that the documents to count are strings produced by docs.
+The docs function should call yield with each document in turn and
+stop once yield returns false, which it does if counting has stopped
+early. Such a function can also be used as an iter.Seq[string].
+
+	docs := func(yield func(string) bool) {
+		for _, d := range database.Documents() {
+			if !yield(d.Body) {
+				return
+			}
+		}
+	}
+	om, err := str.ParallelWordsByOccurrenceSeq(ctx, docs, 8, true)
+*/
+func ParallelWordsByOccurrenceSeq(ctx context.Context, docs func(yield func(string) bool), workers int, fold bool) (OccMap, error) {
+	return parallelCount(ctx, workers, fold, func(yield func(countJob) bool) {
+		docs(func(s string) bool {
+			return yield(func(ctx context.Context, c *Counter) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				c.AddWords(s)
+				return nil
+			})
+		})
+	})
+}
+
+// countJob counts the words of a single document into c.
+type countJob func(ctx context.Context, c *Counter) error
+
+// How many words a reader job counts between checks
+// for cancellation.
+const cancelCheckInterval = 1024
+
+func readerJob(r io.Reader) countJob {
+	return func(ctx context.Context, c *Counter) error {
+		ws := NewWordScanner(r)
+		for n := 1; ws.Scan(); n++ {
+			c.Add(ws.Text(), 1)
+			if n%cancelCheckInterval == 0 && ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+		return ws.Err()
+	}
+}
+
+func parallelCount(ctx context.Context, workers int, fold bool, jobs func(yield func(countJob) bool)) (OccMap, error) {
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	sc := newShardedCounter(workers * 4)
+	ch := make(chan countJob)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range ch {
+				if ctx.Err() != nil {
+					continue
+				}
+				c := NewCounter(fold)
+				if err := job(ctx, c); err != nil {
+					fail(err)
+					continue
+				}
+				sc.merge(c)
+			}
+		}()
+	}
+
+	jobs(func(job countJob) bool {
+		select {
+		case ch <- job:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	close(ch)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return sc.occMap(), nil
+}
+
+/*
+shardedCounter is a set of counts that can be added to concurrently.
+Substrings are spread across several independently locked maps so
+that workers rarely wait on one another.
+*/
+type shardedCounter struct {
+	seed   maphash.Seed
+	shards []counterShard
+}
+
+type counterShard struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newShardedCounter(n int) *shardedCounter {
+	sc := &shardedCounter{
+		seed:   maphash.MakeSeed(),
+		shards: make([]counterShard, n),
+	}
+	for i := range sc.shards {
+		sc.shards[i].counts = make(map[string]int)
+	}
+	return sc
+}
+
+/*
+merge adds the counts in c. They are grouped by shard first so that
+each shard's lock is taken at most once.
+*/
+func (sc *shardedCounter) merge(c *Counter) {
+
+	groups := make([][]Occurrences, len(sc.shards))
+	for s, n := range c.counts {
+		i := maphash.String(sc.seed, s) % uint64(len(sc.shards))
+		groups[i] = append(groups[i], Occurrences{SubStr: s, N: n})
+	}
+
+	for i, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		shard := &sc.shards[i]
+		shard.mu.Lock()
+		for _, o := range group {
+			shard.counts[o.SubStr] += o.N
+		}
+		shard.mu.Unlock()
+	}
+}
+
+func (sc *shardedCounter) occMap() OccMap {
+
+	var n int
+	for i := range sc.shards {
+		n += len(sc.shards[i].counts)
+	}
+
+	om := make(OccMap, 0, n)
+	for i := range sc.shards {
+		for s, n := range sc.shards[i].counts {
+			om = append(om, Occurrences{SubStr: s, N: n})
+		}
+	}
+	return om
+}
@@ -0,0 +1,145 @@
+package str
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var corpus = []string{
+	`"Here's the dialogue," said the narrator/programmer to the listener!!`,
+	"And here's this. Thing, thing and THING",
+	"世界 世界世界 hello I am poop 💩 hi",
+	"",
+	"interrupted\n\n\nstring.\n\n\n",
+}
+
+func occMapsEqual(om1, om2 OccMap) bool {
+	if len(om1) != len(om2) {
+		return false
+	}
+	m := make(map[string]int, len(om1))
+	for _, o := range om1 {
+		m[o.SubStr] = o.N
+	}
+	for _, o := range om2 {
+		if n, ok := m[o.SubStr]; !ok || n != o.N {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParallelWordsByOccurrence(t *testing.T) {
+
+	for _, fold := range []bool{false, true} {
+
+		want := WordsByOccurrence(strings.Join(corpus, " "), fold)
+
+		for _, workers := range []int{0, 1, 3, 16} {
+			rr := make([]io.Reader, len(corpus))
+			for i, s := range corpus {
+				rr[i] = iotest.HalfReader(strings.NewReader(s))
+			}
+			got, err := ParallelWordsByOccurrence(context.Background(), rr, workers, fold)
+			if err != nil {
+				t.Errorf("ParallelWordsByOccurrence(%d workers, %v) returned error %v.", workers, fold, err)
+			}
+			if !occMapsEqual(got, want) {
+				t.Errorf(
+					"ParallelWordsByOccurrence(%d workers, %v)\n"+
+						"    return %v\n"+
+						"    wanted %v",
+					workers, fold, got, want)
+			}
+		}
+	}
+}
+
+func TestParallelWordsByOccurrenceSeq(t *testing.T) {
+
+	docs := func(yield func(string) bool) {
+		for i := 0; i < 100; i++ {
+			if !yield(corpus[i%len(corpus)]) {
+				return
+			}
+		}
+	}
+
+	c := NewCounter(true)
+	docs(func(s string) bool {
+		c.AddWords(s)
+		return true
+	})
+	want := c.OccMap()
+
+	got, err := ParallelWordsByOccurrenceSeq(context.Background(), docs, 4, true)
+	if err != nil {
+		t.Fatalf("ParallelWordsByOccurrenceSeq returned error %v.", err)
+	}
+	if !occMapsEqual(got, want) {
+		t.Errorf(
+			"ParallelWordsByOccurrenceSeq\n"+
+				"    return %v\n"+
+				"    wanted %v",
+			got, want)
+	}
+}
+
+func TestParallelWordsByOccurrenceErrors(t *testing.T) {
+
+	readErr := errors.New("read failed")
+	rr := []io.Reader{
+		strings.NewReader(corpus[0]),
+		iotest.ErrReader(readErr),
+		strings.NewReader(corpus[1]),
+	}
+	if om, err := ParallelWordsByOccurrence(context.Background(), rr, 2, false); err != readErr || om != nil {
+		t.Errorf("ParallelWordsByOccurrence with failing reader returned %v, %v; wanted nil, %v.", om, err, readErr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var yielded int
+	docs := func(yield func(string) bool) {
+		for {
+			yielded++
+			if yielded == 10 {
+				cancel()
+			}
+			if !yield("some words") {
+				return
+			}
+		}
+	}
+	if om, err := ParallelWordsByOccurrenceSeq(ctx, docs, 2, false); err != context.Canceled || om != nil {
+		t.Errorf("ParallelWordsByOccurrenceSeq with cancelled context returned %v, %v; wanted nil, %v.", om, err, context.Canceled)
+	}
+}
+
+func TestParallelWordsByOccurrenceLongRuns(t *testing.T) {
+
+	// Long runs of grammar are counted as WordsByOccurrence
+	// counts them.
+	s := "hello " + strings.Repeat("-", 70000) + " world"
+	want := WordsByOccurrence(s, false)
+
+	rr := []io.Reader{strings.NewReader(s)}
+	got, err := ParallelWordsByOccurrence(context.Background(), rr, 2, false)
+	if err != nil {
+		t.Fatalf("ParallelWordsByOccurrence returned error %v.", err)
+	}
+	if !occMapsEqual(got, want) {
+		t.Errorf("ParallelWordsByOccurrence return %v, wanted %v.", got, want)
+	}
+
+	// Words too long for a WordScanner are an error.
+	s = "hello " + strings.Repeat("a", 70000) + " world"
+	rr = []io.Reader{strings.NewReader(s)}
+	if om, err := ParallelWordsByOccurrence(context.Background(), rr, 2, false); err != bufio.ErrTooLong || om != nil {
+		t.Errorf("ParallelWordsByOccurrence with long word returned %d entries, %v; wanted nil, %v.", len(om), err, bufio.ErrTooLong)
+	}
+}
@@ -0,0 +1,135 @@
+package str
+
+import "unicode/utf8"
+
+/*
+Counter accumulates the number of occurrences of substrings across
+any number of calls, such as when counting the words in many
+documents. Its results can be exported as an OccMap at any time.
+
+If fold is set to true substrings of different cases are counted as
+the same case folded substring, as with WordsByOccurrence.
+
+The zero value is an empty Counter with fold set to false. A Counter
+is not safe for concurrent use.
+
+	c := str.NewCounter(true)
+	c.AddWords("The cat sat.")
+	c.AddWords("The dog sat too.")
+	n := c.Get("the") // 2
+	om := c.OccMap()  // unordered; sort with sort.Sort(om)
+*/
+type Counter struct {
+	fold   bool
+	counts map[string]int
+	total  int
+}
+
+/*
+NewCounter returns an empty Counter. See Counter for the meaning
+of fold.
+*/
+func NewCounter(fold bool) *Counter {
+	return &Counter{fold: fold, counts: make(map[string]int)}
+}
+
+/*
+Add increases the count of subStr by n. A negative n decreases the
+count, and subStr is removed if its count falls to zero or below.
+*/
+func (c *Counter) Add(subStr string, n int) {
+
+	if c.counts == nil {
+		c.counts = make(map[string]int)
+	}
+	if c.fold {
+		subStr = Fold(subStr)
+	}
+
+	old := c.counts[subStr]
+	if old+n <= 0 {
+		delete(c.counts, subStr)
+		c.total -= old
+		return
+	}
+	c.counts[subStr] = old + n
+	c.total += n
+}
+
+/*
+AddWords counts each word in s. See Words for what a word is in
+this context.
+*/
+func (c *Counter) AddWords(s string) {
+	defaultTokenizer.scan(s, func(start, end int) {
+		c.Add(s[start:end], 1)
+	})
+}
+
+/*
+AddChars counts each rune in s.
+*/
+func (c *Counter) AddChars(s string) {
+	for i := 0; i < len(s); {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		c.Add(s[i:i+size], 1)
+		i += size
+	}
+}
+
+/*
+AddAll counts each string in ss, such as the words returned by a
+Tokenizer.
+*/
+func (c *Counter) AddAll(ss []string) {
+	for _, s := range ss {
+		c.Add(s, 1)
+	}
+}
+
+/*
+Merge adds the counts in other to c. If c folds case and other does
+not, the substrings in other are folded as they are merged.
+*/
+func (c *Counter) Merge(other *Counter) {
+	for s, n := range other.counts {
+		c.Add(s, n)
+	}
+}
+
+/*
+Get returns the number of occurrences of subStr. If c folds case
+subStr is folded before it is looked up.
+*/
+func (c *Counter) Get(subStr string) int {
+	if c.fold {
+		subStr = Fold(subStr)
+	}
+	return c.counts[subStr]
+}
+
+/*
+Total returns the sum of the counts of all substrings.
+*/
+func (c *Counter) Total() int {
+	return c.total
+}
+
+/*
+Distinct returns the number of different substrings counted.
+*/
+func (c *Counter) Distinct() int {
+	return len(c.counts)
+}
+
+/*
+OccMap returns the counts as an unordered OccMap. The OccMap is
+a copy and is unaffected by later changes to c.
+*/
+func (c *Counter) OccMap() OccMap {
+	om := make(OccMap, 0, len(c.counts))
+	for s, n := range c.counts {
+		om = append(om, Occurrences{SubStr: s, N: n})
+	}
+	return om
+}
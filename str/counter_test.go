@@ -0,0 +1,118 @@
+package str
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+
+	c := NewCounter(true)
+	c.AddWords("The cat sat on the mat.")
+	c.AddWords("THE dog sat too!")
+
+	cases := []struct {
+		s    string
+		want int
+	}{
+		{"the", 3},
+		{"The", 3},
+		{"sat", 2},
+		{"dog", 1},
+		{"bird", 0},
+	}
+
+	for _, cs := range cases {
+		if got := c.Get(cs.s); got != cs.want {
+			t.Errorf("Counter.Get(%q) return %d, wanted %d.", cs.s, got, cs.want)
+		}
+	}
+
+	if got := c.Total(); got != 10 {
+		t.Errorf("Counter.Total() return %d, wanted 10.", got)
+	}
+	if got := c.Distinct(); got != 7 {
+		t.Errorf("Counter.Distinct() return %d, wanted 7.", got)
+	}
+}
+
+func TestCounterAdd(t *testing.T) {
+
+	var c Counter
+	c.Add("a", 3)
+	c.Add("A", 1)
+	c.Add("a", -1)
+	c.Add("b", 1)
+	c.Add("b", -5)
+
+	if got := c.Get("a"); got != 2 {
+		t.Errorf("Counter.Get(\"a\") return %d, wanted 2.", got)
+	}
+	if got := c.Get("b"); got != 0 {
+		t.Errorf("Counter.Get(\"b\") return %d, wanted 0.", got)
+	}
+	if got := c.Total(); got != 3 {
+		t.Errorf("Counter.Total() return %d, wanted 3.", got)
+	}
+	if got := c.Distinct(); got != 2 {
+		t.Errorf("Counter.Distinct() return %d, wanted 2.", got)
+	}
+}
+
+func TestCounterMerge(t *testing.T) {
+
+	docs := []string{
+		`"Here's the dialogue," said the narrator/programmer`,
+		"to the listener!! And here's this.",
+		"Thing, thing and THING",
+	}
+
+	merged := NewCounter(true)
+	for _, d := range docs {
+		c := NewCounter(false)
+		c.AddWords(d)
+		merged.Merge(c)
+	}
+
+	var all string
+	for _, d := range docs {
+		all += d + " "
+	}
+	want := WordsByOccurrence(all, true)
+	sort.Sort(want)
+
+	got := merged.OccMap()
+	sort.Sort(got)
+	if !occSliceCorrect(got, want) {
+		t.Errorf(
+			"merged Counter.OccMap()\n"+
+				"    return %v\n"+
+				"    wanted %v",
+			got, want)
+	}
+	for _, o := range want {
+		if n := merged.Get(o.SubStr); n != o.N {
+			t.Errorf("merged Counter.Get(%q) return %d, wanted %d.", o.SubStr, n, o.N)
+		}
+	}
+}
+
+func TestCounterAddChars(t *testing.T) {
+
+	c := NewCounter(false)
+	c.AddChars("Hello, 世界!")
+	c.AddChars("世")
+
+	want := CharsByOccurrence("Hello, 世界!世", false)
+	sort.Sort(want)
+
+	got := c.OccMap()
+	sort.Sort(got)
+	if !occSliceCorrect(got, want) {
+		t.Errorf(
+			"Counter.OccMap()\n"+
+				"    return %v\n"+
+				"    wanted %v",
+			got, want)
+	}
+}
@@ -0,0 +1,459 @@
+package str
+
+import (
+	"errors"
+	"strings"
+)
+
+/*
+Comparer measures how similar two strings are. By default strings
+are compared rune by rune (rather than byte by byte) and case is
+significant.
+
+If Fold is true runes of different cases are considered equal, in the
+same way as CharSet. If Graphemes is true strings are compared by
+grapheme cluster rather than by rune, so that a character written with
+a combining accent counts as a single character. See Graphemes.
+
+The package level functions such as Levenshtein use a Comparer with
+Graphemes set to false.
+*/
+type Comparer struct {
+	Fold      bool
+	Graphemes bool
+}
+
+/*
+Levenshtein returns the minimum number of single rune insertions,
+deletions and substitutions needed to change a into b. If fold is
+true runes of different cases are considered equal.
+
+	d := str.Levenshtein("kitten", "sitting", false) // 3
+	d := str.Levenshtein("世界", "世間", false)       // 1
+*/
+func Levenshtein(a, b string, fold bool) int {
+	return Comparer{Fold: fold}.Levenshtein(a, b)
+}
+
+/*
+LevenshteinBounded returns the Levenshtein distance between a and b
+and true if it is no greater than limit. Otherwise it returns limit+1
+and false. It stops as soon as the distance is known to exceed limit, so is
+much faster than Levenshtein when looking for close matches.
+*/
+func LevenshteinBounded(a, b string, limit int, fold bool) (int, bool) {
+	return Comparer{Fold: fold}.LevenshteinBounded(a, b, limit)
+}
+
+/*
+OptimalStringAlignment returns the restricted Damerau-Levenshtein
+distance between a and b. It is the same as Levenshtein except that
+transposing two adjacent runes counts as a single edit, with the
+restriction that no substring is edited more than once. For example,
+"ca" to "abc" takes three edits rather than two.
+
+	d := str.OptimalStringAlignment("ab", "ba", false) // 1
+*/
+func OptimalStringAlignment(a, b string, fold bool) int {
+	return Comparer{Fold: fold}.OptimalStringAlignment(a, b)
+}
+
+/*
+DamerauLevenshtein returns the unrestricted Damerau-Levenshtein
+distance between a and b. It is the same as OptimalStringAlignment
+except that substrings may be edited more than once, so it is a
+true metric.
+
+	d := str.DamerauLevenshtein("ca", "abc", false) // 2
+*/
+func DamerauLevenshtein(a, b string, fold bool) int {
+	return Comparer{Fold: fold}.DamerauLevenshtein(a, b)
+}
+
+/*
+Hamming returns the number of positions at which the runes of a and
+b differ. An error is returned if a and b contain different numbers
+of runes.
+*/
+func Hamming(a, b string, fold bool) (int, error) {
+	return Comparer{Fold: fold}.Hamming(a, b)
+}
+
+/*
+Jaro returns the Jaro similarity of a and b, between 0 for no
+similarity and 1 for an exact match.
+*/
+func Jaro(a, b string, fold bool) float64 {
+	return Comparer{Fold: fold}.Jaro(a, b)
+}
+
+/*
+JaroWinkler returns the Jaro-Winkler similarity of a and b, between
+0 for no similarity and 1 for an exact match. It is the Jaro
+similarity boosted for strings sharing a prefix of up to four runes,
+which makes it well suited to comparing short strings such as names.
+
+	s := str.JaroWinkler("MARTHA", "MARHTA", false) // 0.961
+*/
+func JaroWinkler(a, b string, fold bool) float64 {
+	return Comparer{Fold: fold}.JaroWinkler(a, b)
+}
+
+/*
+Similarity returns the Levenshtein distance between a and b
+normalised to a score between 0 for completely different strings
+and 1 for an exact match.
+*/
+func Similarity(a, b string, fold bool) float64 {
+	return Comparer{Fold: fold}.Similarity(a, b)
+}
+
+/*
+Levenshtein returns the Levenshtein distance between a and b.
+See the package level Levenshtein.
+*/
+func (c Comparer) Levenshtein(a, b string) int {
+	return levenshtein(c.units(a, b))
+}
+
+func levenshtein(x, y []int) int {
+
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+
+	// Only the previous row of the matrix is needed.
+	row := make([]int, len(y)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			above := row[j]
+			row[j] = min(row[j]+1, row[j-1]+1, diag+cost)
+			diag = above
+		}
+	}
+
+	return row[len(y)]
+}
+
+/*
+LevenshteinBounded returns the Levenshtein distance between a and b
+and whether it is within limit. See the package level
+LevenshteinBounded.
+*/
+func (c Comparer) LevenshteinBounded(a, b string, limit int) (int, bool) {
+
+	if limit < 0 {
+		return limit + 1, false
+	}
+
+	x, y := c.units(a, b)
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	if len(x)-len(y) > limit {
+		return limit + 1, false
+	}
+
+	// Cells further than limit from the diagonal must exceed
+	// limit so only a band of the matrix is computed. inf is
+	// used for cells outside the band.
+	inf := limit + 1
+	row := make([]int, len(y)+1)
+	for j := range row {
+		row[j] = min(j, inf)
+	}
+
+	for i := 1; i <= len(x); i++ {
+
+		lo := 1
+		if i-limit > lo {
+			lo = i - limit
+		}
+		hi := len(y)
+		if i+limit < hi {
+			hi = i + limit
+		}
+
+		diag := row[lo-1]
+		if lo == 1 {
+			row[0] = min(i, inf)
+		} else {
+			row[lo-1] = inf
+		}
+
+		rowMin := row[lo-1]
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			above := row[j]
+			row[j] = min(row[j]+1, row[j-1]+1, diag+cost, inf)
+			diag = above
+			rowMin = min(rowMin, row[j])
+		}
+		if hi < len(y) {
+			row[hi+1] = inf
+		}
+
+		if rowMin > limit {
+			return limit + 1, false
+		}
+	}
+
+	d := row[len(y)]
+	return d, d <= limit
+}
+
+/*
+OptimalStringAlignment returns the restricted Damerau-Levenshtein
+distance between a and b. See the package level
+OptimalStringAlignment.
+*/
+func (c Comparer) OptimalStringAlignment(a, b string) int {
+
+	x, y := c.units(a, b)
+
+	// The previous two rows are needed to detect
+	// transpositions.
+	prev2 := make([]int, len(y)+1)
+	prev := make([]int, len(y)+1)
+	row := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		row[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			row[j] = min(prev[j]+1, row[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				row[j] = min(row[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, row = prev, row, prev2
+	}
+
+	return prev[len(y)]
+}
+
+/*
+DamerauLevenshtein returns the unrestricted Damerau-Levenshtein
+distance between a and b. See the package level DamerauLevenshtein.
+*/
+func (c Comparer) DamerauLevenshtein(a, b string) int {
+
+	x, y := c.units(a, b)
+
+	// This is the Lowrance-Wagner algorithm. The matrix has
+	// an extra leading row and column holding the maximum
+	// possible distance.
+	maxDist := len(x) + len(y)
+	w := len(y) + 2
+	d := make([]int, (len(x)+2)*w)
+	at := func(i, j int) *int {
+		return &d[i*w+j]
+	}
+
+	*at(0, 0) = maxDist
+	for i := 0; i <= len(x); i++ {
+		*at(i+1, 0) = maxDist
+		*at(i+1, 1) = i
+	}
+	for j := 0; j <= len(y); j++ {
+		*at(0, j+1) = maxDist
+		*at(1, j+1) = j
+	}
+
+	// lastRow holds the last row in which each unit of y
+	// was seen in x.
+	lastRow := make(map[int]int)
+
+	for i := 1; i <= len(x); i++ {
+		lastCol := 0
+		for j := 1; j <= len(y); j++ {
+			i1 := lastRow[y[j-1]]
+			j1 := lastCol
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			*at(i+1, j+1) = min(
+				*at(i, j)+cost,
+				*at(i+1, j)+1,
+				*at(i, j+1)+1,
+				*at(i1, j1)+(i-i1-1)+1+(j-j1-1),
+			)
+		}
+		lastRow[x[i-1]] = i
+	}
+
+	return *at(len(x)+1, len(y)+1)
+}
+
+/*
+Hamming returns the Hamming distance between a and b. See the package
+level Hamming.
+*/
+func (c Comparer) Hamming(a, b string) (int, error) {
+
+	x, y := c.units(a, b)
+	if len(x) != len(y) {
+		return 0, errors.New("strings are of different lengths")
+	}
+
+	var d int
+	for i := range x {
+		if x[i] != y[i] {
+			d++
+		}
+	}
+	return d, nil
+}
+
+/*
+Jaro returns the Jaro similarity of a and b. See the package level
+Jaro.
+*/
+func (c Comparer) Jaro(a, b string) float64 {
+	x, y := c.units(a, b)
+	return jaro(x, y)
+}
+
+/*
+JaroWinkler returns the Jaro-Winkler similarity of a and b. See the
+package level JaroWinkler.
+*/
+func (c Comparer) JaroWinkler(a, b string) float64 {
+
+	x, y := c.units(a, b)
+	sim := jaro(x, y)
+
+	// Winkler's modification only applies to strings
+	// that are already reasonably similar.
+	if sim <= 0.7 {
+		return sim
+	}
+
+	var prefix int
+	for prefix < min(len(x), len(y), 4) && x[prefix] == y[prefix] {
+		prefix++
+	}
+
+	return sim + float64(prefix)*0.1*(1-sim)
+}
+
+/*
+Similarity returns the normalised Levenshtein similarity of a and b.
+See the package level Similarity.
+*/
+func (c Comparer) Similarity(a, b string) float64 {
+	x, y := c.units(a, b)
+	longest := max(len(x), len(y))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(x, y))/float64(longest)
+}
+
+func jaro(x, y []int) float64 {
+
+	if len(x) == 0 && len(y) == 0 {
+		return 1
+	}
+	if len(x) == 0 || len(y) == 0 {
+		return 0
+	}
+
+	window := max(len(x), len(y))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	xMatched := make([]bool, len(x))
+	yMatched := make([]bool, len(y))
+
+	var matches int
+	for i := range x {
+		lo := max(0, i-window)
+		hi := min(len(y), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !yMatched[j] && x[i] == y[j] {
+				xMatched[i] = true
+				yMatched[j] = true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count matched units that are out of order.
+	var transpositions, j int
+	for i := range x {
+		if !xMatched[i] {
+			continue
+		}
+		for !yMatched[j] {
+			j++
+		}
+		if x[i] != y[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(x)) + m/float64(len(y)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+/*
+units splits a and b into runes or grapheme clusters, folding their
+case if required, and returns them as integers where equal units have
+equal integers. Comparing integers is much cheaper than comparing
+strings in the quadratic algorithms above.
+*/
+func (c Comparer) units(a, b string) ([]int, []int) {
+
+	ids := make(map[string]int)
+	convert := func(s string) []int {
+		var ss []string
+		if c.Graphemes {
+			ss = Graphemes(s)
+		} else {
+			ss = strings.Split(s, "")
+		}
+		ii := make([]int, len(ss))
+		for i, u := range ss {
+			if c.Fold {
+				u = Fold(u)
+			}
+			id, ok := ids[u]
+			if !ok {
+				id = len(ids)
+				ids[u] = id
+			}
+			ii[i] = id
+		}
+		return ii
+	}
+
+	return convert(a), convert(b)
+}
@@ -0,0 +1,189 @@
+package str
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+
+	cases := []struct {
+		a, b string
+		fold bool
+		want int
+	}{
+		{"kitten", "sitting", false, 3},
+		{"sitting", "kitten", false, 3},
+		{"世界", "世間", false, 1},
+		{"💩💩", "💩", false, 1},
+		{"Hello", "hello", false, 1},
+		{"Hello", "hello", true, 0},
+		{"", "abc", false, 3},
+		{"abc", "", false, 3},
+		{"", "", false, 0},
+		{"flaw", "lawn", false, 2},
+	}
+
+	for _, c := range cases {
+		if got := Levenshtein(c.a, c.b, c.fold); got != c.want {
+			t.Errorf("Levenshtein(%q, %q, %v) return %d, wanted %d.", c.a, c.b, c.fold, got, c.want)
+		}
+	}
+}
+
+func TestLevenshteinBounded(t *testing.T) {
+
+	rng := rand.New(rand.NewSource(1))
+	alphabet := []rune("abc世")
+	randStr := func() string {
+		rr := make([]rune, rng.Intn(9))
+		for i := range rr {
+			rr[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return string(rr)
+	}
+
+	for n := 0; n < 2000; n++ {
+		a, b := randStr(), randStr()
+		limit := rng.Intn(6)
+		d := Levenshtein(a, b, false)
+		got, ok := LevenshteinBounded(a, b, limit, false)
+		if d <= limit && (got != d || !ok) || d > limit && (got != limit+1 || ok) {
+			t.Fatalf("LevenshteinBounded(%q, %q, %d) return %d, %v; distance is %d.", a, b, limit, got, ok, d)
+		}
+	}
+}
+
+func TestOptimalStringAlignment(t *testing.T) {
+
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"ab", "ba", 1},
+		{"ca", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"世界", "界世", 1},
+		{"", "ab", 2},
+	}
+
+	for _, c := range cases {
+		if got := OptimalStringAlignment(c.a, c.b, false); got != c.want {
+			t.Errorf("OptimalStringAlignment(%q, %q) return %d, wanted %d.", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+
+	cases := []struct {
+		a, b string
+		fold bool
+		want int
+	}{
+		{"ab", "ba", false, 1},
+		{"ca", "abc", false, 2},
+		{"kitten", "sitting", false, 3},
+		{"a cat", "an act", false, 2},
+		{"AB", "ba", true, 1},
+		{"", "ab", false, 2},
+		{"", "", false, 0},
+	}
+
+	for _, c := range cases {
+		if got := DamerauLevenshtein(c.a, c.b, c.fold); got != c.want {
+			t.Errorf("DamerauLevenshtein(%q, %q, %v) return %d, wanted %d.", c.a, c.b, c.fold, got, c.want)
+		}
+	}
+}
+
+func TestHamming(t *testing.T) {
+
+	cases := []struct {
+		a, b    string
+		want    int
+		wantErr bool
+	}{
+		{"karolin", "kathrin", 3, false},
+		{"世界世", "世間世", 1, false},
+		{"abc", "ab", 0, true},
+		{"", "", 0, false},
+	}
+
+	for _, c := range cases {
+		got, err := Hamming(c.a, c.b, false)
+		if got != c.want || c.wantErr != (err != nil) {
+			t.Errorf("Hamming(%q, %q) return %d, %v; wanted %d, error %v.", c.a, c.b, got, err, c.want, c.wantErr)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+
+	cases := []struct {
+		a, b        string
+		fold        bool
+		jaro, jaroW float64
+	}{
+		{"MARTHA", "MARHTA", false, 0.944, 0.961},
+		{"DWAYNE", "DUANE", false, 0.822, 0.840},
+		{"DIXON", "DICKSONX", false, 0.767, 0.813},
+		{"martha", "MARHTA", true, 0.944, 0.961},
+		{"abc", "xyz", false, 0, 0},
+		{"", "", false, 1, 1},
+		{"", "a", false, 0, 0},
+	}
+
+	for _, c := range cases {
+		if got := Jaro(c.a, c.b, c.fold); math.Abs(got-c.jaro) > 0.001 {
+			t.Errorf("Jaro(%q, %q, %v) return %.3f, wanted %.3f.", c.a, c.b, c.fold, got, c.jaro)
+		}
+		if got := JaroWinkler(c.a, c.b, c.fold); math.Abs(got-c.jaroW) > 0.001 {
+			t.Errorf("JaroWinkler(%q, %q, %v) return %.3f, wanted %.3f.", c.a, c.b, c.fold, got, c.jaroW)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+
+	cases := []struct {
+		a, b string
+		want float64
+	}{
+		{"kitten", "sitting", 1 - 3.0/7},
+		{"same", "same", 1},
+		{"", "", 1},
+		{"abc", "", 0},
+	}
+
+	for _, c := range cases {
+		if got := Similarity(c.a, c.b, false); math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("Similarity(%q, %q) return %f, wanted %f.", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestComparerGraphemes(t *testing.T) {
+
+	// "é" as e + combining acute accent.
+	a, b := "cafe\u0301", "cafe"
+
+	if got := Levenshtein(a, b, false); got != 1 {
+		t.Errorf("Levenshtein(%q, %q) return %d, wanted 1.", a, b, got)
+	}
+	if got := (Comparer{Graphemes: true}).Levenshtein(a, b); got != 1 {
+		t.Errorf("Comparer{Graphemes: true}.Levenshtein(%q, %q) return %d, wanted 1.", a, b, got)
+	}
+	if got, _ := (Comparer{Graphemes: true}).Hamming(a, b); got != 1 {
+		t.Errorf("Comparer{Graphemes: true}.Hamming(%q, %q) return %d, wanted 1.", a, b, got)
+	}
+	if _, err := Hamming(a, b, false); err == nil {
+		t.Errorf("Hamming(%q, %q) returned no error for different rune lengths.", a, b)
+	}
+
+	flags := Comparer{Graphemes: true, Fold: true}
+	if got := flags.DamerauLevenshtein("🇳🇿🇯🇵X", "🇯🇵🇳🇿x"); got != 1 {
+		t.Errorf("Comparer.DamerauLevenshtein of swapped flags return %d, wanted 1.", got)
+	}
+}
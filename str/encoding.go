@@ -0,0 +1,330 @@
+package str
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+/*
+MarshalJSON encodes om as a JSON array of objects in om's order:
+
+	[{"SubStr":"the","N":3},{"SubStr":"a","N":2}]
+
+This is the same encoding encoding/json produces for a plain slice
+of Occurrences. See MarshalJSONObject for a more compact encoding.
+*/
+func (om OccMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]Occurrences(om))
+}
+
+/*
+MarshalJSONObject encodes om as a JSON object mapping each substring
+to its number of occurrences. The keys appear in om's order:
+
+	{"the":3,"a":2}
+*/
+func (om OccMap) MarshalJSONObject() ([]byte, error) {
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, o := range om {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(o.SubStr)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Itoa(o.N))
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+/*
+UnmarshalJSON decodes either of the encodings produced by MarshalJSON
+and MarshalJSONObject into om, preserving the order of the input.
+*/
+func (om *OccMap) UnmarshalJSON(data []byte) error {
+
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*om = nil
+		return nil
+	case len(data) > 0 && data[0] == '[':
+		var occs []Occurrences
+		if err := json.Unmarshal(data, &occs); err != nil {
+			return err
+		}
+		*om = occs
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		return om.unmarshalJSONObject(data)
+	}
+
+	return errors.New("OccMap must be encoded as a JSON array or object")
+}
+
+/*
+unmarshalJSONObject decodes a JSON object token by token since
+decoding into a map would lose the order of its keys.
+*/
+func (om *OccMap) unmarshalJSONObject(data []byte) error {
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	// Opening brace.
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	occs := OccMap{}
+	for dec.More() {
+
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		num, ok := tok.(json.Number)
+		if !ok {
+			return fmt.Errorf("OccMap value for %q is not a number", key)
+		}
+		n, err := strconv.Atoi(string(num))
+		if err != nil {
+			return fmt.Errorf("OccMap value for %q is not an integer", key)
+		}
+
+		occs = append(occs, Occurrences{SubStr: key, N: n})
+	}
+
+	// Closing brace.
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	*om = occs
+	return nil
+}
+
+/*
+WriteCSV writes om to w as CSV with a header row followed by one
+row per substring in om's order:
+
+	substr,n
+	the,3
+	a,2
+*/
+func (om OccMap) WriteCSV(w io.Writer) error {
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"substr", "n"}); err != nil {
+		return err
+	}
+
+	record := make([]string, 2)
+	for _, o := range om {
+		record[0] = o.SubStr
+		record[1] = strconv.Itoa(o.N)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+/*
+ReadCSV reads an OccMap written by WriteCSV from r, preserving the
+order of its rows. The header row is optional. Each row must have
+exactly two fields, the second of which is an integer.
+*/
+func ReadCSV(r io.Reader) (OccMap, error) {
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	cr.ReuseRecord = true
+
+	om := OccMap{}
+	for line := 1; ; line++ {
+
+		record, err := cr.Read()
+		if err == io.EOF {
+			return om, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := strconv.Atoi(record[1])
+		if err != nil {
+			if line == 1 && record[0] == "substr" && record[1] == "n" {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: count %q is not an integer", line, record[1])
+		}
+
+		om = append(om, Occurrences{SubStr: record[0], N: n})
+	}
+}
+
+/*
+The binary encoding of an OccMap begins with binaryMagic followed by
+a version byte and the number of entries as a uvarint. Each entry is
+then the length of its substring as a uvarint, the substring's bytes,
+and its number of occurrences as a varint.
+*/
+const (
+	binaryMagic   = "OCC"
+	binaryVersion = 1
+)
+
+/*
+MarshalBinary encodes om in a compact, versioned binary format that
+is considerably faster to decode than JSON or CSV. The order of om
+is preserved.
+*/
+func (om OccMap) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := om.WriteBinary(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+/*
+UnmarshalBinary decodes data produced by MarshalBinary into om.
+*/
+func (om *OccMap) UnmarshalBinary(data []byte) error {
+	occs, err := ReadBinary(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	*om = occs
+	return nil
+}
+
+/*
+WriteBinary writes om to w in the format produced by MarshalBinary.
+*/
+func (om OccMap) WriteBinary(w io.Writer) error {
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(binaryMagic)
+	bw.WriteByte(binaryVersion)
+
+	var scratch [binary.MaxVarintLen64]byte
+	bw.Write(binary.AppendUvarint(scratch[:0], uint64(len(om))))
+
+	for _, o := range om {
+		bw.Write(binary.AppendUvarint(scratch[:0], uint64(len(o.SubStr))))
+		bw.WriteString(o.SubStr)
+		bw.Write(binary.AppendVarint(scratch[:0], int64(o.N)))
+	}
+
+	return bw.Flush()
+}
+
+/*
+ReadBinary reads an OccMap written by WriteBinary or MarshalBinary
+from r. Nothing after the encoded OccMap is read, so it may be
+followed by other data in the same stream. If r doesn't implement
+io.ByteReader its varints are read a byte at a time, so wrapping r in
+a bufio.Reader is much faster where r is read no further.
+*/
+func ReadBinary(r io.Reader) (OccMap, error) {
+
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = &byteReader{r: r}
+	}
+
+	header := make([]byte, len(binaryMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errBinaryFormat(err)
+	}
+	if string(header[:len(binaryMagic)]) != binaryMagic {
+		return nil, errors.New("not an OccMap binary encoding")
+	}
+	if v := header[len(binaryMagic)]; v != binaryVersion {
+		return nil, fmt.Errorf("unsupported OccMap binary encoding version %d", v)
+	}
+
+	count, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, errBinaryFormat(err)
+	}
+
+	// Don't trust count for the initial allocation
+	// in case the input is corrupt.
+	om := make(OccMap, 0, min(count, 1<<16))
+	var buf bytes.Buffer
+
+	for i := uint64(0); i < count; i++ {
+
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, errBinaryFormat(err)
+		}
+		if size > math.MaxInt32 {
+			return nil, errBinaryFormat(errors.New("substring too long"))
+		}
+
+		// Copying rather than allocating size bytes up front
+		// avoids huge allocations for corrupt input.
+		buf.Reset()
+		if _, err := io.CopyN(&buf, r, int64(size)); err != nil {
+			return nil, errBinaryFormat(err)
+		}
+
+		n, err := binary.ReadVarint(br)
+		if err != nil {
+			return nil, errBinaryFormat(err)
+		}
+
+		om = append(om, Occurrences{SubStr: buf.String(), N: int(n)})
+	}
+
+	return om, nil
+}
+
+// byteReader reads single bytes from r without reading ahead.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (br *byteReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(br.r, br.buf[:]); err != nil {
+		return 0, err
+	}
+	return br.buf[0], nil
+}
+
+func errBinaryFormat(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("invalid OccMap binary encoding: %w", err)
+}
@@ -0,0 +1,151 @@
+package str
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var encodingCases = []OccMap{
+	{{"the", 3}, {"a", 2}, {"世界", 1}, {`quote"comma,`, 1}, {"", 0}, {"neg", -4}},
+	{},
+}
+
+func TestOccMapJSON(t *testing.T) {
+
+	for _, om := range encodingCases {
+
+		data, err := json.Marshal(om)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) returned error %v.", om, err)
+		}
+		var got OccMap
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned error %v.", data, err)
+		}
+		if !occMapIdentical(got, om) {
+			t.Errorf("JSON round trip of %v gave %v.", om, got)
+		}
+
+		data, err = om.MarshalJSONObject()
+		if err != nil {
+			t.Fatalf("MarshalJSONObject(%v) returned error %v.", om, err)
+		}
+		got = nil
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned error %v.", data, err)
+		}
+		if !occMapIdentical(got, om) {
+			t.Errorf("JSON object round trip of %v gave %v.", om, got)
+		}
+	}
+}
+
+func TestOccMapJSONFormat(t *testing.T) {
+
+	om := OccMap{{"the", 3}, {"a", 2}}
+
+	if data, _ := json.Marshal(om); string(data) != `[{"SubStr":"the","N":3},{"SubStr":"a","N":2}]` {
+		t.Errorf("json.Marshal(%v) gave %s.", om, data)
+	}
+	if data, _ := om.MarshalJSONObject(); string(data) != `{"the":3,"a":2}` {
+		t.Errorf("MarshalJSONObject(%v) gave %s.", om, data)
+	}
+
+	bad := []string{`"the"`, `{"the":"3"}`, `{"the":3.5}`, `[{"SubStr":1}]`, `{"the":3`}
+	for _, s := range bad {
+		var got OccMap
+		if err := json.Unmarshal([]byte(s), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) returned %v, wanted error.", s, got)
+		}
+	}
+}
+
+func TestOccMapCSV(t *testing.T) {
+
+	for _, om := range encodingCases {
+		var buf bytes.Buffer
+		if err := om.WriteCSV(&buf); err != nil {
+			t.Fatalf("WriteCSV(%v) returned error %v.", om, err)
+		}
+		got, err := ReadCSV(&buf)
+		if err != nil {
+			t.Fatalf("ReadCSV returned error %v.", err)
+		}
+		if !occMapIdentical(got, om) {
+			t.Errorf("CSV round trip of %v gave %v.", om, got)
+		}
+	}
+
+	got, err := ReadCSV(strings.NewReader("the,3\na,2\n"))
+	if want := (OccMap{{"the", 3}, {"a", 2}}); err != nil || !occMapIdentical(got, want) {
+		t.Errorf("ReadCSV without header gave %v, %v; wanted %v.", got, err, want)
+	}
+
+	bad := []string{"the,x\n", "substr,n\nthe,3,4\n", "the\n"}
+	for _, s := range bad {
+		if got, err := ReadCSV(strings.NewReader(s)); err == nil {
+			t.Errorf("ReadCSV(%q) returned %v, wanted error.", s, got)
+		}
+	}
+}
+
+func TestOccMapBinary(t *testing.T) {
+
+	for _, om := range encodingCases {
+		data, err := om.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%v) returned error %v.", om, err)
+		}
+		var got OccMap
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary returned error %v.", err)
+		}
+		if !occMapIdentical(got, om) {
+			t.Errorf("binary round trip of %v gave %v.", om, got)
+		}
+
+		// Every truncation of the data should be an error.
+		for i := 0; i < len(data); i++ {
+			if err := got.UnmarshalBinary(data[:i]); err == nil {
+				t.Errorf("UnmarshalBinary of %d of %d bytes succeeded.", i, len(data))
+			}
+		}
+	}
+
+	var got OccMap
+	if err := got.UnmarshalBinary([]byte("OCC\x02\x00")); err == nil {
+		t.Errorf("UnmarshalBinary of unknown version succeeded.")
+	}
+	if err := got.UnmarshalBinary([]byte("XYZ\x01\x00")); err == nil {
+		t.Errorf("UnmarshalBinary with bad magic succeeded.")
+	}
+}
+
+func TestReadBinaryEmbedded(t *testing.T) {
+
+	var b bytes.Buffer
+	om := encodingCases[0]
+	if err := om.WriteBinary(&b); err != nil {
+		t.Fatalf("WriteBinary returned error %v.", err)
+	}
+	b.WriteString("trailing data")
+
+	// HalfReader isn't an io.ByteReader.
+	r := iotest.HalfReader(&b)
+	got, err := ReadBinary(r)
+	if err != nil {
+		t.Fatalf("ReadBinary returned error %v.", err)
+	}
+	if !occMapIdentical(got, om) {
+		t.Errorf("ReadBinary return %v, wanted %v.", got, om)
+	}
+
+	rest, _ := io.ReadAll(r)
+	if string(rest) != "trailing data" {
+		t.Errorf("reader holds %q after ReadBinary, wanted %q.", rest, "trailing data")
+	}
+}
@@ -0,0 +1,201 @@
+package str
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+/*
+Graphemes returns a slice of the extended grapheme clusters in s.
+A grapheme cluster is what a reader perceives as a single character
+and may span several runes, such as "é" written as "e" followed by
+a combining acute accent, a flag made of two regional indicators,
+or a family emoji joined with zero width joiners. Boundaries follow
+the rules in Unicode Standard Annex #29.
+
+If s is an empty string the slice will be non-nil and zero length.
+
+	gg := str.Graphemes("é🇳🇿👩‍👩‍👧")
+	// gg is []string{"é", "🇳🇿", "👩‍👩‍👧"}
+*/
+func Graphemes(s string) []string {
+	gg := make([]string, 0, len(s))
+	for s != "" {
+		n := nextGrapheme(s)
+		gg = append(gg, s[:n])
+		s = s[n:]
+	}
+	return gg
+}
+
+/*
+GraphemeLen returns the number of grapheme clusters in s. It is
+the grapheme cluster counterpart to Len; see Graphemes for what a
+grapheme cluster is.
+*/
+func GraphemeLen(s string) int {
+	var n int
+	for s != "" {
+		s = s[nextGrapheme(s):]
+		n++
+	}
+	return n
+}
+
+/*
+ReverseGraphemes returns a new string with its grapheme clusters
+in the reverse order. Unlike Reverse, combining marks remain
+attached to their base characters and multi-rune emoji are not
+broken apart.
+
+	s := str.ReverseGraphemes("café 🇳🇿") // "🇳🇿 éfac"
+*/
+func ReverseGraphemes(s string) string {
+	gg := Graphemes(s)
+	ReverseSlice(gg)
+	return joinGraphemes(gg)
+}
+
+/*
+SliceGraphemes is the same as Slice except that start and end refer
+to grapheme cluster indices rather than rune indices. Negative indices
+and wrapping behave as they do for Slice.
+
+Returns an error if start or end have an absolute value greater
+than the number of grapheme clusters in s.
+
+	s, _ := SliceGraphemes("🇳🇿🇯🇵🇫🇷", 1, 2) // "🇯🇵"
+	s, _ := SliceGraphemes("🇳🇿🇯🇵🇫🇷", -1, 1) // "🇫🇷🇳🇿"
+*/
+func SliceGraphemes(s string, start, end int) (string, error) {
+	gg := Graphemes(s)
+	if abs(start) > len(gg) || abs(end) > len(gg) {
+		return "", errors.New("index out of bounds")
+	}
+	if start < 0 {
+		start = len(gg) + start
+	}
+	if end < 0 {
+		end = len(gg) + end
+	}
+	if start > end {
+		return joinGraphemes(gg[start:]) + joinGraphemes(gg[0:end]), nil
+	}
+	return joinGraphemes(gg[start:end]), nil
+}
+
+func joinGraphemes(gg []string) string {
+	var n int
+	for _, g := range gg {
+		n += len(g)
+	}
+	b := make([]byte, 0, n)
+	for _, g := range gg {
+		b = append(b, g...)
+	}
+	return string(b)
+}
+
+/*
+nextGrapheme returns the length in bytes of the grapheme cluster
+at the start of s. It returns 0 only if s is empty.
+*/
+func nextGrapheme(s string) int {
+
+	if s == "" {
+		return 0
+	}
+
+	// Fast path: a boundary always exists between two ASCII
+	// characters except for CR followed by LF.
+	if s[0] < utf8.RuneSelf && (len(s) == 1 || s[1] < utf8.RuneSelf) {
+		if s[0] == '\r' && len(s) > 1 && s[1] == '\n' {
+			return 2
+		}
+		return 1
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	prev := gcbOf(r)
+
+	// pict tracks progress through an emoji ZWJ sequence
+	// for rule GB11: 1 after Extended_Pictographic Extend*
+	// and 2 once a ZWJ follows that.
+	var pict int
+	if isExtendedPictographic(r) {
+		pict = 1
+	}
+
+	// Number of consecutive regional indicators seen, for
+	// rules GB12 and GB13.
+	var ri int
+	if prev == gcbRegionalIndicator {
+		ri = 1
+	}
+
+	i := size
+	for i < len(s) {
+
+		r, size = utf8.DecodeRuneInString(s[i:])
+		next := gcbOf(r)
+		nextPict := isExtendedPictographic(r)
+
+		if graphemeBoundary(prev, next, pict == 2 && nextPict, ri) {
+			break
+		}
+
+		switch {
+		case pict == 1 && next == gcbExtend:
+		case pict == 1 && next == gcbZWJ:
+			pict = 2
+		case nextPict:
+			pict = 1
+		default:
+			pict = 0
+		}
+
+		if next == gcbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+
+		prev = next
+		i += size
+	}
+
+	return i
+}
+
+/*
+graphemeBoundary reports whether there is a grapheme cluster
+boundary between two runes with the properties prev and next.
+The rule numbers refer to those in UAX #29.
+*/
+func graphemeBoundary(prev, next gcbProp, emojiJoin bool, ri int) bool {
+	switch {
+	case prev == gcbCR && next == gcbLF: // GB3
+		return false
+	case prev == gcbCR, prev == gcbLF, prev == gcbControl: // GB4
+		return true
+	case next == gcbCR, next == gcbLF, next == gcbControl: // GB5
+		return true
+	case prev == gcbL && (next == gcbL || next == gcbV || next == gcbLV || next == gcbLVT): // GB6
+		return false
+	case (prev == gcbLV || prev == gcbV) && (next == gcbV || next == gcbT): // GB7
+		return false
+	case (prev == gcbLVT || prev == gcbT) && next == gcbT: // GB8
+		return false
+	case next == gcbExtend, next == gcbZWJ: // GB9
+		return false
+	case next == gcbSpacingMark: // GB9a
+		return false
+	case prev == gcbPrepend: // GB9b
+		return false
+	case prev == gcbZWJ && emojiJoin: // GB11
+		return false
+	case prev == gcbRegionalIndicator && next == gcbRegionalIndicator: // GB12, GB13
+		return ri%2 == 0
+	}
+	return true // GB999
+}
@@ -0,0 +1,103 @@
+package str
+
+import "testing"
+
+func TestGraphemes(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want []string
+	}{
+		{"Hello", []string{"H", "e", "l", "l", "o"}},
+		{"世界", []string{"世", "界"}},
+		{"été", []string{"é", "t", "é"}},
+		{"\r\n\n", []string{"\r\n", "\n"}},
+		{"🇳🇿🇯🇵", []string{"🇳🇿", "🇯🇵"}},
+		{"🇳🇿🇯", []string{"🇳🇿", "🇯"}},
+		{"👩‍👩‍👧!", []string{"👩‍👩‍👧", "!"}},
+		{"👍🏽👍", []string{"👍🏽", "👍"}},
+		{"a‍👧", []string{"a‍", "👧"}},
+		{"각가", []string{"각", "가"}},
+		{"क्षि", []string{"क्", "षि"}},
+		{"؀1", []string{"؀1"}},
+		{"", []string{}},
+	}
+
+	for _, c := range cases {
+		if got := Graphemes(c.s); !strSliceEqual(got, c.want) {
+			t.Errorf("Graphemes(%q) return %q, wanted %q.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestGraphemeLen(t *testing.T) {
+
+	cases := []struct {
+		want int
+		s    string
+	}{
+		{5, "Hello"},
+		{4, "café"},
+		{1, "👩‍👩‍👧"},
+		{2, "🇳🇿🇯🇵"},
+		{1, "\r\n"},
+		{0, ""},
+	}
+
+	for _, c := range cases {
+		if got := GraphemeLen(c.s); got != c.want {
+			t.Errorf("GraphemeLen(%q) return %d, wanted %d.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestReverseGraphemes(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want string
+	}{
+		{"hello", "olleh"},
+		{"café 🇳🇿", "🇳🇿 éfac"},
+		{"👍🏽x", "x👍🏽"},
+		{"💩", "💩"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := ReverseGraphemes(c.s); got != c.want {
+			t.Errorf("ReverseGraphemes(%q) return %q, wanted %q.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestSliceGraphemes(t *testing.T) {
+
+	cases := []struct {
+		n1      int
+		n2      int
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{1, 4, "Hello", "ell", false},
+		{1, 2, "🇳🇿🇯🇵🇫🇷", "🇯🇵", false},
+		{-1, 1, "🇳🇿🇯🇵🇫🇷", "🇫🇷🇳🇿", false},
+		{0, -1, "café", "caf", false},
+		{3, 4, "café", "é", false},
+		{0, 5, "café", "", true},
+		{0, 0, "", "", false},
+		{0, 1, "", "", true},
+	}
+
+	for _, c := range cases {
+		got, err := SliceGraphemes(c.s, c.n1, c.n2)
+		if got != c.want || c.wantErr != (err != nil) {
+			t.Errorf(
+				"SliceGraphemes(%q, %d, %d)\n"+
+					"    return %q, %v\n"+
+					"    wanted %q, error %v.\n",
+				c.s, c.n1, c.n2, got, err, c.want, c.wantErr)
+		}
+	}
+}
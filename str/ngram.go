@@ -0,0 +1,128 @@
+package str
+
+import "strings"
+
+/*
+CharNGrams returns every sequence of n consecutive runes (rather
+than bytes) in s in order of their appearance. If n is less than 1
+or s contains fewer than n runes the slice will be non-nil and zero
+length.
+
+	gg := str.CharNGrams("héllo", 3)
+	// gg is []string{"hél", "éll", "llo"}
+*/
+func CharNGrams(s string, n int) []string {
+	return ngrams(strings.Split(s, ""), n, 0, "")
+}
+
+/*
+WordNGrams returns every sequence of n consecutive words in s in order
+of their appearance, with the words in each separated by a single
+space. If n is less than 1 or s contains fewer than n words the slice
+will be non-nil and zero length.
+
+See Words for what a word is in this context.
+
+	gg := str.WordNGrams("The cat sat, the cat ran.", 2)
+	// gg is []string{"The cat", "cat sat", "sat the", "the cat", "cat ran"}
+*/
+func WordNGrams(s string, n int) []string {
+	return ngrams(Words(s), n, 0, " ")
+}
+
+/*
+CharSkipGrams is the same as CharNGrams except that up to k runes in
+total may be skipped between the runes of each n-gram. The result
+includes the ordinary n-grams of s, which skip nothing. N-grams are
+ordered by the position of their first rune and then by the
+positions of the runes that follow.
+
+	gg := str.CharSkipGrams("abcd", 2, 1)
+	// gg is []string{"ab", "ac", "bc", "bd", "cd"}
+*/
+func CharSkipGrams(s string, n, k int) []string {
+	return ngrams(strings.Split(s, ""), n, k, "")
+}
+
+/*
+WordSkipGrams is the same as WordNGrams except that up to k words in
+total may be skipped between the words of each n-gram. See
+CharSkipGrams for the order of the results.
+
+	gg := str.WordSkipGrams("insurgents killed in ongoing fighting", 2, 2)
+	// gg is []string{
+	// 	"insurgents killed", "insurgents in", "insurgents ongoing",
+	// 	"killed in", "killed ongoing", "killed fighting",
+	// 	"in ongoing", "in fighting",
+	// 	"ongoing fighting",
+	// }
+*/
+func WordSkipGrams(s string, n, k int) []string {
+	return ngrams(Words(s), n, k, " ")
+}
+
+/*
+CharNGramsByOccurrence returns an unordered OccMap where each index
+represents an n-gram of runes and the number of times it appears in
+s. See CharNGrams for what an n-gram is in this context and
+CharsByOccurrence for the meaning of fold.
+*/
+func CharNGramsByOccurrence(s string, n int, fold bool) OccMap {
+	return occurrences(CharNGrams(s, n), fold)
+}
+
+/*
+WordNGramsByOccurrence returns an unordered OccMap where each index
+represents an n-gram of words and the number of times it appears in
+s. See WordNGrams for what an n-gram is in this context and
+WordsByOccurrence for the meaning of fold.
+*/
+func WordNGramsByOccurrence(s string, n int, fold bool) OccMap {
+	return occurrences(WordNGrams(s, n), fold)
+}
+
+/*
+ngrams returns the k-skip-n-grams of units, joining the units in each
+with sep. When k is 0 these are the ordinary n-grams.
+*/
+func ngrams(units []string, n, k int, sep string) []string {
+
+	if n < 1 || len(units) < n {
+		return []string{}
+	}
+	if k < 0 {
+		k = 0
+	}
+
+	grams := make([]string, 0, len(units)-n+1)
+	idx := make([]int, n)
+
+	// fill chooses the units for positions pos onward
+	// of the n-gram, with skip units left to skip.
+	var fill func(pos, skip int)
+	fill = func(pos, skip int) {
+		if pos == n {
+			gram := units[idx[0]]
+			for _, i := range idx[1:] {
+				gram += sep + units[i]
+			}
+			grams = append(grams, gram)
+			return
+		}
+		for s := 0; s <= skip; s++ {
+			i := idx[pos-1] + 1 + s
+			if i+(n-pos-1) >= len(units) {
+				return
+			}
+			idx[pos] = i
+			fill(pos+1, skip-s)
+		}
+	}
+
+	for i := 0; i+n <= len(units); i++ {
+		idx[0] = i
+		fill(1, k)
+	}
+
+	return grams
+}
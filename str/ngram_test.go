@@ -0,0 +1,102 @@
+package str
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCharNGrams(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		n    int
+		want []string
+	}{
+		{"héllo", 3, []string{"hél", "éll", "llo"}},
+		{"世界💩", 2, []string{"世界", "界💩"}},
+		{"abc", 1, []string{"a", "b", "c"}},
+		{"abc", 3, []string{"abc"}},
+		{"abc", 4, []string{}},
+		{"abc", 0, []string{}},
+		{"", 1, []string{}},
+	}
+
+	for _, c := range cases {
+		if got := CharNGrams(c.s, c.n); !strSliceEqual(got, c.want) {
+			t.Errorf("CharNGrams(%q, %d) return %q, wanted %q.", c.s, c.n, got, c.want)
+		}
+	}
+}
+
+func TestWordNGrams(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		n    int
+		want []string
+	}{
+		{"The cat sat, the cat ran.", 2, []string{"The cat", "cat sat", "sat the", "the cat", "cat ran"}},
+		{`"Here's" an em—dash`, 3, []string{"Here's an em", "an em dash"}},
+		{"one", 2, []string{}},
+		{"one two", -1, []string{}},
+	}
+
+	for _, c := range cases {
+		if got := WordNGrams(c.s, c.n); !strSliceEqual(got, c.want) {
+			t.Errorf("WordNGrams(%q, %d) return %q, wanted %q.", c.s, c.n, got, c.want)
+		}
+	}
+}
+
+func TestCharSkipGrams(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		n    int
+		k    int
+		want []string
+	}{
+		{"abcd", 2, 0, []string{"ab", "bc", "cd"}},
+		{"abcd", 2, 1, []string{"ab", "ac", "bc", "bd", "cd"}},
+		{"abcde", 3, 1, []string{"abc", "abd", "acd", "bcd", "bce", "bde", "cde"}},
+		{"ab", 3, 2, []string{}},
+	}
+
+	for _, c := range cases {
+		if got := CharSkipGrams(c.s, c.n, c.k); !strSliceEqual(got, c.want) {
+			t.Errorf("CharSkipGrams(%q, %d, %d) return %q, wanted %q.", c.s, c.n, c.k, got, c.want)
+		}
+	}
+}
+
+func TestWordSkipGrams(t *testing.T) {
+
+	s := "Insurgents killed in ongoing fighting."
+	want := []string{
+		"Insurgents killed", "Insurgents in", "Insurgents ongoing",
+		"killed in", "killed ongoing", "killed fighting",
+		"in ongoing", "in fighting",
+		"ongoing fighting",
+	}
+
+	if got := WordSkipGrams(s, 2, 2); !strSliceEqual(got, want) {
+		t.Errorf("WordSkipGrams(%q, 2, 2) return %q, wanted %q.", s, got, want)
+	}
+}
+
+func TestNGramsByOccurrence(t *testing.T) {
+
+	got := WordNGramsByOccurrence("The cat sat, the cat ran.", 2, true)
+	sort.Sort(got)
+	want := OccMap{{"the cat", 2}, {"cat ran", 1}, {"cat sat", 1}, {"sat the", 1}}
+	if !occMapIdentical(got, want) {
+		t.Errorf("WordNGramsByOccurrence gave %v, wanted %v.", got, want)
+	}
+
+	got = CharNGramsByOccurrence("Banana", 2, true)
+	sort.Sort(got)
+	want = OccMap{{"an", 2}, {"na", 2}, {"ba", 1}}
+	if !occMapIdentical(got, want) {
+		t.Errorf("CharNGramsByOccurrence gave %v, wanted %v.", got, want)
+	}
+}
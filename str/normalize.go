@@ -0,0 +1,337 @@
+package str
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Form is a Unicode normalization form as defined by Unicode Standard
+Annex #15. Text that looks the same may be written with different
+sequences of runes; "é" may be a single rune or an "e" followed by a
+combining acute accent. Normalizing two such strings to the same Form
+makes them compare equal.
+*/
+type Form int
+
+const (
+	// NFC composes characters where possible, after
+	// canonical decomposition. Most text is already NFC.
+	NFC Form = iota
+
+	// NFD decomposes characters into base characters and
+	// combining marks.
+	NFD
+
+	// NFKC is the same as NFC but first also replaces
+	// compatibility characters, such as "ﬁ" and "²", with
+	// their plain equivalents, "fi" and "2".
+	NFKC
+
+	// NFKD is the same as NFD but also replaces
+	// compatibility characters.
+	NFKD
+)
+
+/*
+Normalize returns s in normalization form f. Normalization follows
+the data of Unicode 15.0.
+
+	n := str.Normalize("cafe\u0301", str.NFC)      // "caf\u00e9"
+	n := str.Normalize("caf\u00e9", str.NFD)       // "cafe\u0301"
+	n := str.Normalize("\ufb01ne\u00b2", str.NFKC) // "fine2"
+*/
+func Normalize(s string, f Form) string {
+	if isASCII(s) {
+		return s
+	}
+	rr, _ := normalizeRunes(s, f)
+	return string(rr)
+}
+
+/*
+IsNormalized reports whether s is already in normalization form f.
+*/
+func IsNormalized(s string, f Form) bool {
+	return Normalize(s, f) == s
+}
+
+/*
+StripMarks returns s with diacritics and other combining marks
+removed from the characters they are attached to. The result is in
+NFC.
+
+	s := str.StripMarks("Crème brûlée") // "Creme brulee"
+	s := str.StripMarks("Ἀθῆναι")        // "Αθηναι"
+*/
+func StripMarks(s string) string {
+	if isASCII(s) {
+		return s
+	}
+	rr, _ := normalizeRunes(s, NFD)
+	kept := rr[:0]
+	for _, r := range rr {
+		if !unicode.Is(unicode.Mn, r) {
+			kept = append(kept, r)
+		}
+	}
+	return Normalize(string(kept), NFC)
+}
+
+/*
+CharSetNorm is the same as CharSet except that s is first normalized
+to form f, so that equivalent characters are considered duplicates.
+The characters returned are in form f.
+*/
+func CharSetNorm(s string, fold bool, f Form) []string {
+	return CharSet(Normalize(s, f), fold)
+}
+
+/*
+CharsByOccurrenceNorm is the same as CharsByOccurrence except that s
+is first normalized to form f, so that equivalent characters are
+counted together.
+*/
+func CharsByOccurrenceNorm(s string, fold bool, f Form) OccMap {
+	return CharsByOccurrence(Normalize(s, f), fold)
+}
+
+/*
+WordSetNorm is the same as WordSet except that s is first normalized
+to form f, so that words written with different but equivalent runes
+are considered duplicates. The words returned are in form f.
+*/
+func WordSetNorm(s string, fold bool, f Form) []string {
+	return WordSet(Normalize(s, f), fold)
+}
+
+/*
+WordsByOccurrenceNorm is the same as WordsByOccurrence except that s
+is first normalized to form f, so that words written with different
+but equivalent runes are counted together.
+
+	om := str.WordsByOccurrenceNorm("café café", false, str.NFC)
+	// om holds {"café", 2}
+*/
+func WordsByOccurrenceNorm(s string, fold bool, f Form) OccMap {
+	return WordsByOccurrence(Normalize(s, f), fold)
+}
+
+/*
+NthNorm is the same as Nth except that s and subStr are compared in
+normalization form f, so that equivalent text matches regardless of
+how it is written. The index returned is a rune index into s itself
+rather than its normalized form. A match that starts within a
+character that normalization splits apart or joins to its neighbour
+returns the index of the start of that character.
+
+	i := str.NthNorm("café café", "café", 2, str.NFC) // 6
+*/
+func NthNorm(s, subStr string, n int, f Form) int {
+
+	rr, origin := normalizeRunes(s, f)
+	i := Nth(string(rr), Normalize(subStr, f), n)
+	if i < 0 || i >= len(origin) {
+		if i == len(origin) {
+			return Len(s)
+		}
+		return i
+	}
+	return origin[i]
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+normalizeRunes returns s in form f along with, for each rune of the
+result, the rune index in s of the character it came from.
+*/
+func normalizeRunes(s string, f Form) ([]rune, []int) {
+
+	compat := f == NFKC || f == NFKD
+	rr := make([]rune, 0, len(s))
+	origin := make([]int, 0, len(s))
+
+	var i int
+	for _, r := range s {
+		for _, d := range decompose(r, compat) {
+			rr = append(rr, d)
+			origin = append(origin, i)
+		}
+		i++
+	}
+
+	reorder(rr, origin)
+
+	if f == NFC || f == NFKC {
+		rr, origin = compose(rr, origin)
+	}
+
+	return rr, origin
+}
+
+// Constants for the algorithmic decomposition of Hangul syllables.
+const (
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulNCount = hangulVCount * hangulTCount
+)
+
+/*
+decompose returns the full canonical, or if compat is true
+compatibility, decomposition of r.
+*/
+func decompose(r rune, compat bool) []rune {
+
+	if r < utf8.RuneSelf {
+		return []rune{r}
+	}
+
+	if r >= hangulBase && r <= hangulLast {
+		i := r - hangulBase
+		l := hangulLBase + i/hangulNCount
+		v := hangulVBase + (i%hangulNCount)/hangulTCount
+		if t := i % hangulTCount; t != 0 {
+			return []rune{l, v, hangulTBase + t}
+		}
+		return []rune{l, v}
+	}
+
+	if compat {
+		if d, ok := compatDecomp[r]; ok {
+			return []rune(d)
+		}
+	}
+	if d, ok := canonicalDecomp[r]; ok {
+		return []rune(d)
+	}
+	return []rune{r}
+}
+
+/*
+reorder puts each run of combining marks in rr into canonical order
+by sorting it by combining class, keeping origin in step.
+*/
+func reorder(rr []rune, origin []int) {
+	for i := 0; i < len(rr); {
+		if combiningClass(rr[i]) == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(rr) && combiningClass(rr[j]) != 0 {
+			j++
+		}
+		if j-i > 1 {
+			sort.Stable(marks{rr[i:j], origin[i:j]})
+		}
+		i = j
+	}
+}
+
+type marks struct {
+	rr     []rune
+	origin []int
+}
+
+func (m marks) Len() int {
+	return len(m.rr)
+}
+
+func (m marks) Less(i, j int) bool {
+	return combiningClass(m.rr[i]) < combiningClass(m.rr[j])
+}
+
+func (m marks) Swap(i, j int) {
+	m.rr[i], m.rr[j] = m.rr[j], m.rr[i]
+	m.origin[i], m.origin[j] = m.origin[j], m.origin[i]
+}
+
+/*
+compose applies the canonical composition algorithm to rr, which must
+be fully decomposed and in canonical order. A composed character takes
+the origin of its starter.
+*/
+func compose(rr []rune, origin []int) ([]rune, []int) {
+
+	out := rr[:0]
+	outOrigin := origin[:0]
+	starter := -1
+
+	// lastClass is the combining class of the last rune kept
+	// since the starter, or -1 if there isn't one. A rune is
+	// blocked from the starter unless its class is greater.
+	lastClass := -1
+
+	for i, r := range rr {
+
+		class := int(combiningClass(r))
+
+		if starter >= 0 && lastClass < class {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+
+		if class == 0 {
+			starter = len(out)
+			lastClass = -1
+		} else {
+			lastClass = class
+		}
+		out = append(out, r)
+		outOrigin = append(outOrigin, origin[i])
+	}
+
+	return out, outOrigin
+}
+
+/*
+composePair returns the primary composite of a and b, if there is
+one.
+*/
+func composePair(a, b rune) (rune, bool) {
+
+	// Hangul syllables are composed algorithmically.
+	switch {
+	case a >= hangulLBase && a < hangulLBase+hangulLCount &&
+		b >= hangulVBase && b < hangulVBase+hangulVCount:
+		l, v := a-hangulLBase, b-hangulVBase
+		return hangulBase + (l*hangulVCount+v)*hangulTCount, true
+	case a >= hangulBase && a <= hangulLast && (a-hangulBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount:
+		return a + b - hangulTBase, true
+	}
+
+	c, ok := compositions[[2]rune{a, b}]
+	return c, ok
+}
+
+/*
+combiningClass returns the Canonical_Combining_Class of r, which is
+zero for starters.
+*/
+func combiningClass(r rune) uint8 {
+	if r < 0x300 {
+		return 0
+	}
+	i := sort.Search(len(combiningClasses), func(i int) bool {
+		return combiningClasses[i].hi >= r
+	})
+	if i < len(combiningClasses) && combiningClasses[i].lo <= r {
+		return combiningClasses[i].class
+	}
+	return 0
+}
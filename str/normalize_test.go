@@ -0,0 +1,161 @@
+package str
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		f    Form
+		want string
+	}{
+		{"cafe\u0301", NFC, "café"},
+		{"café", NFD, "cafe\u0301"},
+		{"café", NFC, "café"},
+		{"ﬁne²", NFKC, "fine2"},
+		{"ﬁne²", NFC, "ﬁne²"},
+		{"ẛ\u0323", NFC, "ẛ\u0323"},
+		{"ẛ\u0323", NFD, "ſ\u0323\u0307"},
+		{"ẛ\u0323", NFKC, "ṩ"},
+		{"ẛ\u0323", NFKD, "s\u0323\u0307"},
+
+		// Marks are reordered by combining class.
+		{"a\u0301\u0323", NFD, "a\u0323\u0301"},
+		{"a\u0301\u0323", NFC, "ạ\u0301"},
+
+		// Blocked marks don't compose.
+		{"a\u0301\u0301", NFC, "á\u0301"},
+
+		// Singletons and composition exclusions.
+		{"\u212b", NFC, "\u00c5"},
+		{"\u2126", NFC, "\u03a9"},
+		{"\u0958", NFC, "\u0915\u093c"},
+
+		// Hangul.
+		{"한", NFC, "한"},
+		{"\ud55c", NFD, "\u1112\u1161\u11ab"},
+		{"\u1100\u1161", NFC, "\uac00"},
+
+		{"ASCII only", NFKD, "ASCII only"},
+		{"", NFC, ""},
+	}
+
+	for _, c := range cases {
+		if got := Normalize(c.s, c.f); got != c.want {
+			t.Errorf("Normalize(%+q, %d) return %+q, wanted %+q.", c.s, c.f, got, c.want)
+		}
+	}
+}
+
+func TestIsNormalized(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		f    Form
+		want bool
+	}{
+		{"café", NFC, true},
+		{"café", NFD, false},
+		{"cafe\u0301", NFD, true},
+		{"²", NFKC, false},
+		{"abc", NFKD, true},
+	}
+
+	for _, c := range cases {
+		if got := IsNormalized(c.s, c.f); got != c.want {
+			t.Errorf("IsNormalized(%+q, %d) return %v, wanted %v.", c.s, c.f, got, c.want)
+		}
+	}
+}
+
+func TestStripMarks(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want string
+	}{
+		{"Crème brûlée", "Creme brulee"},
+		{"cafe\u0301", "cafe"},
+		{"Ἀθῆναι", "Αθηναι"},
+		{"Łódź", "Łodz"},
+		{"한국어", "한국어"},
+		{"plain", "plain"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := StripMarks(c.s); got != c.want {
+			t.Errorf("StripMarks(%q) return %q, wanted %q.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestCharSetNorm(t *testing.T) {
+
+	s := "café cafe\u0301"
+	want := []string{"c", "a", "f", "é", " "}
+
+	if got := CharSetNorm(s, false, NFC); !strSliceEqual(got, want) {
+		t.Errorf("CharSetNorm(%+q, false, NFC) return %s, wanted %s.", s, quoteSlice(got), quoteSlice(want))
+	}
+
+	om := CharsByOccurrenceNorm(s, false, NFC)
+	wantOcc := OccMap{{"c", 2}, {"a", 2}, {"f", 2}, {"é", 2}, {" ", 1}}
+	if !occMapsEqual(om, wantOcc) {
+		t.Errorf("CharsByOccurrenceNorm(%+q, false, NFC) return %v, wanted %v.", s, om, wantOcc)
+	}
+}
+
+func TestWordSetNorm(t *testing.T) {
+
+	s := "Café cafe\u0301 CAFE\u0301"
+
+	cases := []struct {
+		f    Form
+		fold bool
+		want []string
+	}{
+		{NFC, false, []string{"Café", "café", "CAFÉ"}},
+		{NFC, true, []string{"café"}},
+		{NFD, true, []string{"cafe\u0301"}},
+	}
+
+	for _, c := range cases {
+		if got := WordSetNorm(s, c.fold, c.f); !strSliceEqual(got, c.want) {
+			t.Errorf("WordSetNorm(%+q, %v, %d) return %s, wanted %s.", s, c.fold, c.f, quoteSlice(got), quoteSlice(c.want))
+		}
+	}
+
+	om := WordsByOccurrenceNorm(s, true, NFC)
+	want := OccMap{{"café", 3}}
+	if !occMapsEqual(om, want) {
+		t.Errorf("WordsByOccurrenceNorm(%+q, true, NFC) return %v, wanted %v.", s, om, want)
+	}
+}
+
+func TestNthNorm(t *testing.T) {
+
+	cases := []struct {
+		s      string
+		subStr string
+		n      int
+		f      Form
+		want   int
+	}{
+		{"café cafe\u0301", "café", 2, NFC, 5},
+		{"café cafe\u0301", "cafe\u0301", 1, NFC, 0},
+		{"cafe\u0301 café", "café", -1, NFD, 6},
+		{"cafe\u0301 café", "é", 2, NFC, 9},
+		{"cafe\u0301 café", "e", 1, NFD, 3},
+		{"ﬁne", "fine", 1, NFKC, 0},
+		{"ﬁne", "fine", 1, NFC, -1},
+		{"cafe\u0301", "", -1, NFC, 5},
+		{"cafe\u0301", "x", 1, NFC, -1},
+	}
+
+	for _, c := range cases {
+		if got := NthNorm(c.s, c.subStr, c.n, c.f); got != c.want {
+			t.Errorf("NthNorm(%+q, %+q, %d, %d) return %d, wanted %d.", c.s, c.subStr, c.n, c.f, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,1029 @@
+package str
+
+import "strings"
+
+/*
+Soundex returns the American Soundex code for word: its first letter
+followed by three digits describing the consonants that follow. Words
+that sound alike, such as "Robert" and "Rupert", share a code. Letters
+outside A to Z are ignored and the result is an empty string if word
+contains none.
+
+	c := str.Soundex("Robert")   // "R163"
+	c := str.Soundex("Tymczak")  // "T522"
+	c := str.Soundex("Pfister")  // "P236"
+*/
+func Soundex(word string) string {
+
+	letters := phoneticLetters(word)
+	if letters == "" {
+		return ""
+	}
+
+	code := []byte{letters[0]}
+	last := soundexCode(letters[0])
+
+	for i := 1; i < len(letters) && len(code) < 4; i++ {
+		c := letters[i]
+		d := soundexCode(c)
+		if d != '0' && d != last {
+			code = append(code, d)
+		}
+
+		// H and W don't separate consonants with the same
+		// code, while vowels do.
+		if c != 'H' && c != 'W' {
+			last = d
+		}
+	}
+
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+
+	return string(code)
+}
+
+// soundexCode returns the Soundex digit for an upper case letter,
+// or '0' for letters that aren't coded.
+func soundexCode(c byte) byte {
+	return "01230120022455012623010202"[c-'A']
+}
+
+/*
+RefinedSoundex returns the Refined Soundex code for word. It keeps
+the first letter followed by a digit for every letter, including the
+first, with adjacent duplicate digits collapsed. It distinguishes more
+sounds than Soundex and its codes are not truncated. Letters outside
+A to Z are ignored.
+
+	c := str.RefinedSoundex("testing") // "T6036084"
+*/
+func RefinedSoundex(word string) string {
+
+	letters := phoneticLetters(word)
+	if letters == "" {
+		return ""
+	}
+
+	code := []byte{letters[0]}
+	var last byte
+
+	for i := 0; i < len(letters); i++ {
+		d := "01360240043788015936020505"[letters[i]-'A']
+		if d != last {
+			code = append(code, d)
+		}
+		last = d
+	}
+
+	return string(code)
+}
+
+/*
+NYSIIS returns the New York State Identification and Intelligence
+System code for word, truncated to six letters as in the original
+algorithm. It is more accurate than Soundex for many surnames. Letters
+outside A to Z are ignored.
+
+	c := str.NYSIIS("Bishop") // "BASAP"
+	c := str.NYSIIS("Knight") // "NAGT"
+*/
+func NYSIIS(word string) string {
+
+	w := phoneticLetters(word)
+	if w == "" {
+		return ""
+	}
+
+	// Translate the first characters.
+	switch {
+	case strings.HasPrefix(w, "MAC"):
+		w = "MCC" + w[3:]
+	case strings.HasPrefix(w, "KN"):
+		w = "NN" + w[2:]
+	case strings.HasPrefix(w, "K"):
+		w = "C" + w[1:]
+	case strings.HasPrefix(w, "PH"), strings.HasPrefix(w, "PF"):
+		w = "FF" + w[2:]
+	case strings.HasPrefix(w, "SCH"):
+		w = "SSS" + w[3:]
+	}
+
+	// Translate the last characters.
+	if len(w) > 1 {
+		switch w[len(w)-2:] {
+		case "EE", "IE":
+			w = w[:len(w)-2] + "Y"
+		case "DT", "RT", "RD", "NT", "ND":
+			w = w[:len(w)-2] + "D"
+		}
+	}
+
+	cc := []byte(w)
+	key := []byte{cc[0]}
+
+	at := func(i int) byte {
+		if i < 0 || i >= len(cc) {
+			return 0
+		}
+		return cc[i]
+	}
+
+	for i := 1; i < len(cc); i++ {
+
+		prev, cur, next := cc[i-1], cc[i], at(i+1)
+
+		var t string
+		switch {
+		case cur == 'E' && next == 'V':
+			t = "AF"
+		case isPhoneticVowel(cur):
+			t = "A"
+		case cur == 'Q':
+			t = "G"
+		case cur == 'Z':
+			t = "S"
+		case cur == 'M':
+			t = "N"
+		case cur == 'K' && next == 'N':
+			t = "N"
+		case cur == 'K':
+			t = "C"
+		case cur == 'S' && next == 'C' && at(i+2) == 'H':
+			t = "SSS"
+		case cur == 'P' && next == 'H':
+			t = "FF"
+		case cur == 'H' && (!isPhoneticVowel(prev) || !isPhoneticVowel(next)):
+			t = string(prev)
+		case cur == 'W' && isPhoneticVowel(prev):
+			t = string(prev)
+		default:
+			t = string(cur)
+		}
+
+		// The translation overwrites the characters that
+		// follow, which are then translated in turn.
+		copy(cc[i:], t)
+
+		if cc[i] != key[len(key)-1] {
+			key = append(key, cc[i])
+		}
+	}
+
+	if len(key) > 1 {
+		if key[len(key)-1] == 'S' {
+			key = key[:len(key)-1]
+		}
+		if n := len(key); n > 2 && key[n-2] == 'A' && key[n-1] == 'Y' {
+			key = append(key[:n-2], 'Y')
+		}
+		if n := len(key); n > 1 && key[n-1] == 'A' {
+			key = key[:n-1]
+		}
+	}
+
+	if len(key) > 6 {
+		key = key[:6]
+	}
+
+	return string(key)
+}
+
+/*
+Metaphone returns the original Metaphone code for word as described
+by Lawrence Philips. It encodes English pronunciation more accurately
+than Soundex and its codes are not truncated. In keeping with the
+original algorithm "TH" is encoded as "0". Letters outside A to Z are
+ignored.
+
+	c := str.Metaphone("Knight") // "NT"
+	c := str.Metaphone("Smith")  // "SM0"
+*/
+func Metaphone(word string) string {
+
+	w := phoneticLetters(word)
+	if len(w) < 2 {
+		return w
+	}
+
+	// Initial letter exceptions.
+	switch {
+	case w[1] == 'N' && (w[0] == 'K' || w[0] == 'G' || w[0] == 'P'):
+		w = w[1:]
+	case w[0] == 'A' && w[1] == 'E':
+		w = w[1:]
+	case w[0] == 'W' && w[1] == 'R':
+		w = w[1:]
+	case w[0] == 'W' && w[1] == 'H':
+		w = "W" + w[2:]
+	case w[0] == 'X':
+		w = "S" + w[1:]
+	}
+
+	at := func(i int) byte {
+		if i < 0 || i >= len(w) {
+			return 0
+		}
+		return w[i]
+	}
+	region := func(i int, sub string) bool {
+		return i >= 0 && strings.HasPrefix(w[i:], sub)
+	}
+	frontVowel := func(i int) bool {
+		c := at(i)
+		return c == 'E' || c == 'I' || c == 'Y'
+	}
+
+	var code []byte
+	for n := 0; n < len(w); n++ {
+
+		c := w[n]
+		last := n == len(w)-1
+
+		// Skip duplicate letters except C.
+		if c != 'C' && at(n-1) == c {
+			continue
+		}
+
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if n == 0 {
+				code = append(code, c)
+			}
+		case 'B':
+			// Silent in a final "MB".
+			if !(at(n-1) == 'M' && last) {
+				code = append(code, 'B')
+			}
+		case 'C':
+			switch {
+			case at(n-1) == 'S' && frontVowel(n+1):
+				// Silent in "SCI", "SCE" and "SCY".
+			case region(n, "CIA"):
+				code = append(code, 'X')
+			case frontVowel(n + 1):
+				code = append(code, 'S')
+			case at(n-1) == 'S' && at(n+1) == 'H':
+				code = append(code, 'K')
+			case at(n+1) == 'H':
+				if n == 0 && len(w) >= 3 && isPhoneticVowel(at(2)) {
+					code = append(code, 'K')
+				} else {
+					code = append(code, 'X')
+				}
+			default:
+				code = append(code, 'K')
+			}
+		case 'D':
+			if at(n+1) == 'G' && frontVowel(n+2) {
+				code = append(code, 'J')
+				n += 2
+			} else {
+				code = append(code, 'T')
+			}
+		case 'G':
+			switch {
+			case at(n+1) == 'H' && n+2 == len(w):
+				// Silent in a final "GH".
+			case at(n+1) == 'H' && !isPhoneticVowel(at(n+2)):
+				// Silent in "GH" before a consonant.
+			case n > 0 && region(n, "GN"):
+				// Silent in "GN" and "GNED".
+			case frontVowel(n+1) && at(n-1) != 'G':
+				code = append(code, 'J')
+			default:
+				code = append(code, 'K')
+			}
+		case 'H':
+			if !last && !strings.ContainsRune("CSPTG", rune(at(n-1))) && isPhoneticVowel(at(n+1)) {
+				code = append(code, 'H')
+			}
+		case 'F', 'J', 'L', 'M', 'N', 'R':
+			code = append(code, c)
+		case 'K':
+			if at(n-1) != 'C' {
+				code = append(code, 'K')
+			}
+		case 'P':
+			if at(n+1) == 'H' {
+				code = append(code, 'F')
+			} else {
+				code = append(code, 'P')
+			}
+		case 'Q':
+			code = append(code, 'K')
+		case 'S':
+			if region(n, "SH") || region(n, "SIO") || region(n, "SIA") {
+				code = append(code, 'X')
+			} else {
+				code = append(code, 'S')
+			}
+		case 'T':
+			switch {
+			case region(n, "TIA"), region(n, "TIO"):
+				code = append(code, 'X')
+			case region(n, "TCH"):
+				// Silent.
+			case region(n, "TH"):
+				code = append(code, '0')
+			default:
+				code = append(code, 'T')
+			}
+		case 'V':
+			code = append(code, 'F')
+		case 'W', 'Y':
+			if isPhoneticVowel(at(n + 1)) {
+				code = append(code, c)
+			}
+		case 'X':
+			code = append(code, 'K', 'S')
+		case 'Z':
+			code = append(code, 'S')
+		}
+	}
+
+	return string(code)
+}
+
+/*
+DoubleMetaphone returns the primary and secondary Double Metaphone
+codes for word, each at most four characters long. The secondary code
+allows for an alternative pronunciation, typically from a language
+other than English, and is the same as the primary code if there
+isn't one. Two words are likely to sound alike if either of their
+codes match.
+
+Letters outside A to Z other than Ç and Ñ are ignored, though spaces
+are kept since some rules depend on them.
+
+	p, s := str.DoubleMetaphone("Smith")   // "SM0", "XMT"
+	p, s := str.DoubleMetaphone("Schmidt") // "XMT", "SMT"
+*/
+func DoubleMetaphone(word string) (primary, secondary string) {
+
+	var rr []rune
+	for _, r := range strings.ToUpper(word) {
+		if r >= 'A' && r <= 'Z' || r == 'Ç' || r == 'Ñ' || r == ' ' {
+			rr = append(rr, r)
+		}
+	}
+	if len(rr) == 0 {
+		return "", ""
+	}
+
+	e := &dmEncoder{
+		rr:     rr,
+		length: len(rr),
+		last:   len(rr) - 1,
+	}
+
+	// Pad so that rules can look past the end of the word.
+	e.rr = append(e.rr, []rune("     ")...)
+
+	e.encode()
+
+	primary, secondary = e.primary.String(), e.secondary.String()
+	if len(primary) > 4 {
+		primary = primary[:4]
+	}
+	if len(secondary) > 4 {
+		secondary = secondary[:4]
+	}
+	return primary, secondary
+}
+
+/*
+dmEncoder holds the state of a Double Metaphone encoding. The rules
+in encode follow Lawrence Philips' original implementation closely so
+that they can be checked against it.
+*/
+type dmEncoder struct {
+	rr                 []rune
+	length, last       int
+	primary, secondary strings.Builder
+}
+
+func (e *dmEncoder) at(i int) rune {
+	if i < 0 || i >= len(e.rr) {
+		return 0
+	}
+	return e.rr[i]
+}
+
+// is reports whether any of subs appears in the word at start.
+func (e *dmEncoder) is(start int, subs ...string) bool {
+	if start < 0 {
+		return false
+	}
+	for _, sub := range subs {
+		end := start + len(sub)
+		if end <= len(e.rr) && string(e.rr[start:end]) == sub {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *dmEncoder) vowel(i int) bool {
+	switch e.at(i) {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		return true
+	}
+	return false
+}
+
+func (e *dmEncoder) slavoGermanic() bool {
+	s := string(e.rr)
+	return strings.Contains(s, "W") || strings.Contains(s, "K") ||
+		strings.Contains(s, "CZ") || strings.Contains(s, "WITZ")
+}
+
+// add appends code to both the primary and secondary codes.
+func (e *dmEncoder) add(code string) {
+	e.primary.WriteString(code)
+	e.secondary.WriteString(code)
+}
+
+// add2 appends different codes to the primary and secondary codes.
+func (e *dmEncoder) add2(primary, secondary string) {
+	e.primary.WriteString(primary)
+	e.secondary.WriteString(secondary)
+}
+
+// skip returns 2 if the rune after i is any of rr and 1 otherwise.
+func (e *dmEncoder) skip(i int, rr ...rune) int {
+	for _, r := range rr {
+		if e.at(i+1) == r {
+			return 2
+		}
+	}
+	return 1
+}
+
+func (e *dmEncoder) encode() {
+
+	cur := 0
+
+	// Skip these when at the start of a word.
+	if e.is(0, "GN", "KN", "PN", "WR", "PS") {
+		cur++
+	}
+
+	// Initial X is pronounced Z, as in "Xavier".
+	if e.at(0) == 'X' {
+		e.add("S")
+		cur++
+	}
+
+	for cur < e.length && (e.primary.Len() < 4 || e.secondary.Len() < 4) {
+
+		switch e.at(cur) {
+
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			if cur == 0 {
+				e.add("A")
+			}
+			cur++
+
+		case 'B':
+			e.add("P")
+			cur += e.skip(cur, 'B')
+
+		case 'Ç':
+			e.add("S")
+			cur++
+
+		case 'C':
+			cur += e.encodeC(cur)
+
+		case 'D':
+			switch {
+			case e.is(cur, "DG") && e.is(cur+2, "I", "E", "Y"):
+				// As in "edge".
+				e.add("J")
+				cur += 3
+			case e.is(cur, "DG"):
+				// As in "Edgar".
+				e.add("TK")
+				cur += 2
+			case e.is(cur, "DT", "DD"):
+				e.add("T")
+				cur += 2
+			default:
+				e.add("T")
+				cur++
+			}
+
+		case 'F':
+			e.add("F")
+			cur += e.skip(cur, 'F')
+
+		case 'G':
+			cur += e.encodeG(cur)
+
+		case 'H':
+			// Only kept if first or between vowels.
+			if (cur == 0 || e.vowel(cur-1)) && e.vowel(cur+1) {
+				e.add("H")
+				cur += 2
+			} else {
+				cur++
+			}
+
+		case 'J':
+			cur += e.encodeJ(cur)
+
+		case 'K':
+			e.add("K")
+			cur += e.skip(cur, 'K')
+
+		case 'L':
+			if e.at(cur+1) == 'L' {
+				// Spanish, as in "Cabrillo" and "Gallegos".
+				if cur == e.length-3 && e.is(cur-1, "ILLO", "ILLA", "ALLE") ||
+					(e.is(e.last-1, "AS", "OS") || e.is(e.last, "A", "O")) && e.is(cur-1, "ALLE") {
+					e.add2("L", "")
+					cur += 2
+					break
+				}
+				cur += 2
+			} else {
+				cur++
+			}
+			e.add("L")
+
+		case 'M':
+			if e.is(cur-1, "UMB") && (cur+1 == e.last || e.is(cur+2, "ER")) || e.at(cur+1) == 'M' {
+				cur += 2
+			} else {
+				cur++
+			}
+			e.add("M")
+
+		case 'N':
+			e.add("N")
+			cur += e.skip(cur, 'N')
+
+		case 'Ñ':
+			e.add("N")
+			cur++
+
+		case 'P':
+			if e.at(cur+1) == 'H' {
+				e.add("F")
+				cur += 2
+				break
+			}
+			// Also accounts for "Campbell" and "raspberry".
+			e.add("P")
+			cur += e.skip(cur, 'P', 'B')
+
+		case 'Q':
+			e.add("K")
+			cur += e.skip(cur, 'Q')
+
+		case 'R':
+			// French, as in "Rogier", but not "Hochmeier".
+			if cur == e.last && !e.slavoGermanic() && e.is(cur-2, "IE") && !e.is(cur-4, "ME", "MA") {
+				e.add2("", "R")
+			} else {
+				e.add("R")
+			}
+			cur += e.skip(cur, 'R')
+
+		case 'S':
+			cur += e.encodeS(cur)
+
+		case 'T':
+			switch {
+			case e.is(cur, "TION"), e.is(cur, "TIA", "TCH"):
+				e.add("X")
+				cur += 3
+			case e.is(cur, "TH", "TTH"):
+				// As in "Thomas" and "Thames", or Germanic.
+				if e.is(cur+2, "OM", "AM") || e.is(0, "VAN ", "VON ", "SCH") {
+					e.add("T")
+				} else {
+					e.add2("0", "T")
+				}
+				cur += 2
+			default:
+				e.add("T")
+				cur += e.skip(cur, 'T', 'D')
+			}
+
+		case 'V':
+			e.add("F")
+			cur += e.skip(cur, 'V')
+
+		case 'W':
+			cur += e.encodeW(cur)
+
+		case 'X':
+			// French, as in "Breaux".
+			if !(cur == e.last && (e.is(cur-3, "IAU", "EAU") || e.is(cur-2, "AU", "OU"))) {
+				e.add("KS")
+			}
+			cur += e.skip(cur, 'C', 'X')
+
+		case 'Z':
+			switch {
+			case e.at(cur+1) == 'H':
+				// Chinese pinyin, as in "Zhao".
+				e.add("J")
+				cur += 2
+				continue
+			case e.is(cur+1, "ZO", "ZI", "ZA") || e.slavoGermanic() && cur > 0 && e.at(cur-1) != 'T':
+				e.add2("S", "TS")
+			default:
+				e.add("S")
+			}
+			cur += e.skip(cur, 'Z')
+
+		default:
+			cur++
+		}
+	}
+}
+
+// encodeC encodes the C at cur and returns how many runes it used.
+func (e *dmEncoder) encodeC(cur int) int {
+
+	// Various Germanic.
+	if cur > 1 && !e.vowel(cur-2) && e.is(cur-1, "ACH") && e.at(cur+2) != 'I' &&
+		(e.at(cur+2) != 'E' || e.is(cur-2, "BACHER", "MACHER")) {
+		e.add("K")
+		return 2
+	}
+
+	if cur == 0 && e.is(cur, "CAESAR") {
+		e.add("S")
+		return 2
+	}
+
+	// Italian, as in "Chianti".
+	if e.is(cur, "CHIA") {
+		e.add("K")
+		return 2
+	}
+
+	if e.is(cur, "CH") {
+
+		// As in "Michael".
+		if cur > 0 && e.is(cur, "CHAE") {
+			e.add2("K", "X")
+			return 2
+		}
+
+		// Greek roots, as in "chemistry" and "chorus".
+		if cur == 0 && (e.is(cur+1, "HARAC", "HARIS") || e.is(cur+1, "HOR", "HYM", "HIA", "HEM")) && !e.is(0, "CHORE") {
+			e.add("K")
+			return 2
+		}
+
+		// Germanic, Greek or otherwise pronounced as KH.
+		if e.is(0, "VAN ", "VON ", "SCH") ||
+			e.is(cur-2, "ORCHES", "ARCHIT", "ORCHID") ||
+			e.is(cur+2, "T", "S") ||
+			(e.is(cur-1, "A", "O", "U", "E") || cur == 0) &&
+				e.is(cur+2, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") {
+			e.add("K")
+		} else if cur > 0 {
+			if e.is(0, "MC") {
+				e.add("K")
+			} else {
+				e.add2("X", "K")
+			}
+		} else {
+			e.add("X")
+		}
+		return 2
+	}
+
+	// As in "Czerny".
+	if e.is(cur, "CZ") && !e.is(cur-2, "WICZ") {
+		e.add2("S", "X")
+		return 2
+	}
+
+	// As in "focaccia".
+	if e.is(cur+1, "CIA") {
+		e.add("X")
+		return 3
+	}
+
+	// Double C, but not as in "McClellan".
+	if e.is(cur, "CC") && !(cur == 1 && e.at(0) == 'M') {
+		// As in "Bellocchio" but not "Bacchus".
+		if e.is(cur+2, "I", "E", "H") && !e.is(cur+2, "HU") {
+			if cur == 1 && e.at(cur-1) == 'A' || e.is(cur-1, "UCCEE", "UCCES") {
+				// As in "accident", "accede" and "succeed".
+				e.add("KS")
+			} else {
+				// As in "Bacci" and "Bertucci".
+				e.add("X")
+			}
+			return 3
+		}
+		e.add("K")
+		return 2
+	}
+
+	if e.is(cur, "CK", "CG", "CQ") {
+		e.add("K")
+		return 2
+	}
+
+	if e.is(cur, "CI", "CE", "CY") {
+		// Italian or English.
+		if e.is(cur, "CIO", "CIE", "CIA") {
+			e.add2("S", "X")
+		} else {
+			e.add("S")
+		}
+		return 2
+	}
+
+	e.add("K")
+
+	// As in "Mac Caffrey" and "Mac Gregor".
+	switch {
+	case e.is(cur+1, " C", " Q", " G"):
+		return 3
+	case e.is(cur+1, "C", "K", "Q") && !e.is(cur+1, "CE", "CI"):
+		return 2
+	}
+	return 1
+}
+
+// encodeG encodes the G at cur and returns how many runes it used.
+func (e *dmEncoder) encodeG(cur int) int {
+
+	if e.at(cur+1) == 'H' {
+
+		if cur > 0 && !e.vowel(cur-1) {
+			e.add("K")
+			return 2
+		}
+
+		// As in "Ghislane" and "Ghiradelli".
+		if cur == 0 {
+			if e.at(cur+2) == 'I' {
+				e.add("J")
+			} else {
+				e.add("K")
+			}
+			return 2
+		}
+
+		// Parker's rule, as in "Hugh", "bough" and "Broughton".
+		if cur > 1 && e.is(cur-2, "B", "H", "D") ||
+			cur > 2 && e.is(cur-3, "B", "H", "D") ||
+			cur > 3 && e.is(cur-4, "B", "H") {
+			return 2
+		}
+
+		// As in "laugh", "McLaughlin", "cough" and "rough".
+		if cur > 2 && e.at(cur-1) == 'U' && e.is(cur-3, "C", "G", "L", "R", "T") {
+			e.add("F")
+		} else if cur > 0 && e.at(cur-1) != 'I' {
+			e.add("K")
+		}
+		return 2
+	}
+
+	if e.at(cur+1) == 'N' {
+		switch {
+		case cur == 1 && e.vowel(0) && !e.slavoGermanic():
+			e.add2("KN", "N")
+		case !e.is(cur+2, "EY") && e.at(cur+1) != 'Y' && !e.slavoGermanic():
+			// Not as in "Cagney".
+			e.add2("N", "KN")
+		default:
+			e.add("KN")
+		}
+		return 2
+	}
+
+	// As in "Tagliaro".
+	if e.is(cur+1, "LI") && !e.slavoGermanic() {
+		e.add2("KL", "L")
+		return 2
+	}
+
+	// -GES-, -GEP-, -GEL- and -GIE- at the start.
+	if cur == 0 && (e.at(cur+1) == 'Y' ||
+		e.is(cur+1, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")) {
+		e.add2("K", "J")
+		return 2
+	}
+
+	// -GER- and -GY-.
+	if (e.is(cur+1, "ER") || e.at(cur+1) == 'Y') && !e.is(0, "DANGER", "RANGER", "MANGER") &&
+		!e.is(cur-1, "E", "I") && !e.is(cur-1, "RGY", "OGY") {
+		e.add2("K", "J")
+		return 2
+	}
+
+	// Italian, as in "Biaggi".
+	if e.is(cur+1, "E", "I", "Y") || e.is(cur-1, "AGGI", "OGGI") {
+		switch {
+		case e.is(0, "VAN ", "VON ", "SCH") || e.is(cur+1, "ET"):
+			// Obviously Germanic.
+			e.add("K")
+		case e.is(cur+1, "IER "):
+			// Always soft with a French ending.
+			e.add("J")
+		default:
+			e.add2("J", "K")
+		}
+		return 2
+	}
+
+	e.add("K")
+	return e.skip(cur, 'G')
+}
+
+// encodeJ encodes the J at cur and returns how many runes it used.
+func (e *dmEncoder) encodeJ(cur int) int {
+
+	// Obviously Spanish, as in "Jose" and "San Jacinto".
+	if e.is(cur, "JOSE") || e.is(0, "SAN ") {
+		if cur == 0 && e.at(cur+4) == ' ' || e.is(0, "SAN ") {
+			e.add("H")
+		} else {
+			e.add2("J", "H")
+		}
+		return 1
+	}
+
+	switch {
+	case cur == 0:
+		// As in "Yankelovich" and "Jankelowicz".
+		e.add2("J", "A")
+	case e.vowel(cur-1) && !e.slavoGermanic() && (e.at(cur+1) == 'A' || e.at(cur+1) == 'O'):
+		// Spanish, as in "bajador".
+		e.add2("J", "H")
+	case cur == e.last:
+		e.add2("J", "")
+	case !e.is(cur+1, "L", "T", "K", "S", "N", "M", "B", "Z") && !e.is(cur-1, "S", "K", "L"):
+		e.add("J")
+	}
+	return e.skip(cur, 'J')
+}
+
+// encodeS encodes the S at cur and returns how many runes it used.
+func (e *dmEncoder) encodeS(cur int) int {
+
+	// As in "island", "isle", "Carlisle" and "Carlysle".
+	if e.is(cur-1, "ISL", "YSL") {
+		return 1
+	}
+
+	if cur == 0 && e.is(cur, "SUGAR") {
+		e.add2("X", "S")
+		return 1
+	}
+
+	if e.is(cur, "SH") {
+		// Germanic.
+		if e.is(cur+1, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			e.add("S")
+		} else {
+			e.add("X")
+		}
+		return 2
+	}
+
+	// Italian and Armenian.
+	if e.is(cur, "SIO", "SIA", "SIAN") {
+		if e.slavoGermanic() {
+			e.add("S")
+		} else {
+			e.add2("S", "X")
+		}
+		return 3
+	}
+
+	// German and anglicised, as in "Smith" matching "Schmidt"
+	// and "Snider" matching "Schneider". SZ is also Slavic,
+	// though pronounced S in Hungarian.
+	if cur == 0 && e.is(cur+1, "M", "N", "L", "W") || e.is(cur+1, "Z") {
+		e.add2("S", "X")
+		return e.skip(cur, 'Z')
+	}
+
+	if e.is(cur, "SC") {
+
+		// Schlesinger's rule.
+		if e.at(cur+2) == 'H' {
+			switch {
+			case e.is(cur+3, "ER", "EN"):
+				// As in "Schermerhorn" and "Schenker".
+				e.add2("X", "SK")
+			case e.is(cur+3, "OO", "UY", "ED", "EM"):
+				// Dutch, as in "school" and "schooner".
+				e.add("SK")
+			case cur == 0 && !e.vowel(3) && e.at(3) != 'W':
+				e.add2("X", "S")
+			default:
+				e.add("X")
+			}
+			return 3
+		}
+
+		if e.is(cur+2, "I", "E", "Y") {
+			e.add("S")
+		} else {
+			e.add("SK")
+		}
+		return 3
+	}
+
+	// French, as in "Resnais" and "Artois".
+	if cur == e.last && e.is(cur-2, "AI", "OI") {
+		e.add2("", "S")
+	} else {
+		e.add("S")
+	}
+	return e.skip(cur, 'S', 'Z')
+}
+
+// encodeW encodes the W at cur and returns how many runes it used.
+func (e *dmEncoder) encodeW(cur int) int {
+
+	// Can also be in the middle of a word.
+	if e.is(cur, "WR") {
+		e.add("R")
+		return 2
+	}
+
+	if cur == 0 && (e.vowel(cur+1) || e.is(cur, "WH")) {
+		// Wasserman should match Vasserman.
+		if e.vowel(cur + 1) {
+			e.add2("A", "F")
+		} else {
+			e.add("A")
+		}
+	}
+
+	// Arnow should match Arnoff.
+	if cur == e.last && e.vowel(cur-1) || e.is(cur-1, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || e.is(0, "SCH") {
+		e.add2("", "F")
+		return 1
+	}
+
+	// Polish, as in "Filipowicz".
+	if e.is(cur, "WICZ", "WITZ") {
+		e.add2("TS", "FX")
+		return 4
+	}
+
+	return 1
+}
+
+/*
+PhoneticGroups groups words by the key encode returns for each, such
+as the result of WordSet grouped by Soundex. Words keep the order
+they have in words and words whose key is empty are omitted.
+
+	groups := str.PhoneticGroups(str.WordSet(s, true), str.Metaphone)
+
+Double Metaphone can be used by choosing one of its keys:
+
+	groups := str.PhoneticGroups(words, func(w string) string {
+		primary, _ := str.DoubleMetaphone(w)
+		return primary
+	})
+*/
+func PhoneticGroups(words []string, encode func(word string) string) map[string][]string {
+	groups := make(map[string][]string)
+	for _, w := range words {
+		if key := encode(w); key != "" {
+			groups[key] = append(groups[key], w)
+		}
+	}
+	return groups
+}
+
+/*
+phoneticLetters returns s in upper case with everything but the
+letters A to Z removed.
+*/
+func phoneticLetters(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			b = append(b, byte(r))
+		case r >= 'a' && r <= 'z':
+			b = append(b, byte(r-'a'+'A'))
+		}
+	}
+	return string(b)
+}
+
+func isPhoneticVowel(c byte) bool {
+	return c == 'A' || c == 'E' || c == 'I' || c == 'O' || c == 'U'
+}
@@ -0,0 +1,142 @@
+package str
+
+import "testing"
+
+func TestSoundex(t *testing.T) {
+
+	cases := []struct {
+		word string
+		want string
+	}{
+		{"Robert", "R163"},
+		{"Rupert", "R163"},
+		{"Rubin", "R150"},
+		{"Ashcraft", "A261"},
+		{"Tymczak", "T522"},
+		{"Pfister", "P236"},
+		{"Honeyman", "H555"},
+		{"lee", "L000"},
+		{"O'Hara", "O600"},
+		{"", ""},
+		{"123", ""},
+	}
+
+	for _, c := range cases {
+		if got := Soundex(c.word); got != c.want {
+			t.Errorf("Soundex(%q) return %q, wanted %q.", c.word, got, c.want)
+		}
+	}
+}
+
+func TestRefinedSoundex(t *testing.T) {
+
+	cases := []struct {
+		word string
+		want string
+	}{
+		{"testing", "T6036084"},
+		{"Braz", "B1905"},
+		{"Caren", "C30908"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := RefinedSoundex(c.word); got != c.want {
+			t.Errorf("RefinedSoundex(%q) return %q, wanted %q.", c.word, got, c.want)
+		}
+	}
+}
+
+func TestNYSIIS(t *testing.T) {
+
+	cases := []struct {
+		word string
+		want string
+	}{
+		{"Bishop", "BASAP"},
+		{"Carr", "CAR"},
+		{"Greene", "GRAN"},
+		{"Knight", "NAGT"},
+		{"Lynch", "LYNC"},
+		{"Watkins", "WATCAN"},
+		{"Wheeler", "WALAR"},
+		{"Carlson", "CARLSA"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := NYSIIS(c.word); got != c.want {
+			t.Errorf("NYSIIS(%q) return %q, wanted %q.", c.word, got, c.want)
+		}
+	}
+}
+
+func TestMetaphone(t *testing.T) {
+
+	cases := []struct {
+		word string
+		want string
+	}{
+		{"Knight", "NT"},
+		{"Smith", "SM0"},
+		{"Xavier", "SFR"},
+		{"thumb", "0M"},
+		{"Wright", "RT"},
+		{"science", "SNS"},
+		{"a", "A"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := Metaphone(c.word); got != c.want {
+			t.Errorf("Metaphone(%q) return %q, wanted %q.", c.word, got, c.want)
+		}
+	}
+}
+
+func TestDoubleMetaphone(t *testing.T) {
+
+	cases := []struct {
+		word      string
+		primary   string
+		secondary string
+	}{
+		{"Smith", "SM0", "XMT"},
+		{"Schmidt", "XMT", "SMT"},
+		{"Jose", "HS", "HS"},
+		{"Xavier", "SF", "SFR"},
+		{"Arnow", "ARN", "ARNF"},
+		{"Knight", "NT", "NT"},
+		{"Michael", "MKL", "MXL"},
+		{"Caesar", "SSR", "SSR"},
+		{"", "", ""},
+	}
+
+	for _, c := range cases {
+		p, s := DoubleMetaphone(c.word)
+		if p != c.primary || s != c.secondary {
+			t.Errorf("DoubleMetaphone(%q) return %q, %q, wanted %q, %q.", c.word, p, s, c.primary, c.secondary)
+		}
+	}
+}
+
+func TestPhoneticGroups(t *testing.T) {
+
+	words := []string{"Robert", "Rubin", "", "Rupert", "Ashcraft"}
+	groups := PhoneticGroups(words, Soundex)
+
+	want := map[string][]string{
+		"R163": {"Robert", "Rupert"},
+		"R150": {"Rubin"},
+		"A261": {"Ashcraft"},
+	}
+
+	if len(groups) != len(want) {
+		t.Errorf("PhoneticGroups(%s, Soundex) return %d groups, wanted %d.", quoteSlice(words), len(groups), len(want))
+	}
+	for key, ww := range want {
+		if !strSliceEqual(groups[key], ww) {
+			t.Errorf("PhoneticGroups(%s, Soundex)[%q] is %s, wanted %s.", quoteSlice(words), key, quoteSlice(groups[key]), quoteSlice(ww))
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package str
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
+
+/*
+ScanWords is a split function for a bufio.Scanner that returns each
+word in its input. The words are exactly those that Words would
+return for the same input, including where words and multi-byte
+runes straddle the scanner's buffer boundaries. It will never return
+an empty token.
+
+Grammar and boundaries that come before a word are consumed as they
+are read, so long runs of them, such as a line of 100,000 dashes,
+never fill the scanner's buffer. A word, together with any grammar
+after it up to the next boundary, must fit in the buffer; if it
+doesn't the scan stops with bufio.ErrTooLong.
+
+	sc := bufio.NewScanner(f)
+	sc.Split(str.ScanWords)
+	for sc.Scan() {
+		fmt.Println(sc.Text())
+	}
+*/
+func ScanWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return defaultTokenizer.scanWords(data, atEOF)
+}
+
+/*
+SplitFunc returns a split function for a bufio.Scanner that returns
+each word in its input according to t's rules. See ScanWords.
+*/
+func (t *Tokenizer) SplitFunc() bufio.SplitFunc {
+	return t.scanWords
+}
+
+func (t *Tokenizer) scanWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+
+	i := 0
+	for {
+
+		// Skip boundaries.
+		for i < len(data) {
+			if !atEOF && !utf8.FullRune(data[i:]) {
+				return i, nil, nil
+			}
+			r, size := utf8.DecodeRune(data[i:])
+			if !t.splits(r) {
+				break
+			}
+			i += size
+		}
+		if i == len(data) {
+			return i, nil, nil
+		}
+
+		// Find the end of this run of non-boundaries, noting
+		// where the grammar at either end of it lies.
+		first, last := -1, -1
+		for i < len(data) {
+			if !atEOF && !utf8.FullRune(data[i:]) {
+				break
+			}
+			r, size := utf8.DecodeRune(data[i:])
+			if t.splits(r) {
+				break
+			}
+			if !t.isGrammar(r) {
+				if first < 0 {
+					first = i
+				}
+				last = i + size
+			}
+			i += size
+		}
+
+		// The word may continue in data we haven't seen yet.
+		// Grammar before it is dropped straight away as it
+		// can't be part of the word.
+		if !atEOF && (i == len(data) || !utf8.FullRune(data[i:])) {
+			if first < 0 {
+				return i, nil, nil
+			}
+			return first, nil, nil
+		}
+
+		if first >= 0 && !t.Stopwords.Contains(string(data[first:last])) {
+			return i, data[first:last], nil
+		}
+	}
+}
+
+/*
+WordScanner reads words from an io.Reader without holding the whole
+input in memory. It yields the same words as Words would for the
+entire input. Successive calls to Scan step through the words, which
+are available from Text.
+
+	ws := str.NewWordScanner(f)
+	for ws.Scan() {
+		fmt.Println(ws.Text())
+	}
+	if err := ws.Err(); err != nil {
+		// Handle error.
+	}
+*/
+type WordScanner struct {
+	sc *bufio.Scanner
+}
+
+/*
+NewWordScanner returns a WordScanner that reads from r using the
+rules of Words.
+*/
+func NewWordScanner(r io.Reader) *WordScanner {
+	return defaultTokenizer.NewWordScanner(r)
+}
+
+/*
+NewWordScanner returns a WordScanner that reads from r using t's rules.
+*/
+func (t *Tokenizer) NewWordScanner(r io.Reader) *WordScanner {
+	sc := bufio.NewScanner(r)
+	sc.Split(t.scanWords)
+	return &WordScanner{sc: sc}
+}
+
+/*
+Buffer sets the initial buffer and the maximum size of buffer that
+may be allocated while scanning, as for bufio.Scanner's Buffer. The
+maximum limits the length of a single word; Scan stops with
+bufio.ErrTooLong if a word exceeds it. It defaults to
+bufio.MaxScanTokenSize. See ScanWords. Buffer must be called before
+the first call to Scan.
+*/
+func (ws *WordScanner) Buffer(buf []byte, max int) {
+	ws.sc.Buffer(buf, max)
+}
+
+/*
+Scan advances to the next word, which will then be available through
+Text or Bytes. It returns false when there are no more words, either
+by reaching the end of the input or an error.
+*/
+func (ws *WordScanner) Scan() bool {
+	return ws.sc.Scan()
+}
+
+/*
+Text returns the most recent word found by a call to Scan.
+*/
+func (ws *WordScanner) Text() string {
+	return ws.sc.Text()
+}
+
+/*
+Bytes returns the most recent word found by a call to Scan. The
+underlying array may be overwritten by a subsequent call to Scan.
+*/
+func (ws *WordScanner) Bytes() []byte {
+	return ws.sc.Bytes()
+}
+
+/*
+Err returns the first non-EOF error encountered by the WordScanner.
+*/
+func (ws *WordScanner) Err() error {
+	return ws.sc.Err()
+}
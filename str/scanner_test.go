@@ -0,0 +1,149 @@
+package str
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var scanCases = []string{
+	"",
+	"\n\n\n  \n\n\n",
+	"grammar at end,,)",
+	"    Status: happy",
+	"Status::(happy)",
+	"ei\nther/or",
+	`"here's an em—dash"`,
+	"hi,,    my name is thing",
+	"世界 世界世界",
+	"hello I am poop 💩 that's my face",
+	"interrupted\n\n\nstring.\n\n\n",
+	"—— ,,, ——",
+	"a",
+	"ends with a multi-byte rune 界",
+}
+
+func TestScanWords(t *testing.T) {
+
+	for _, s := range scanCases {
+
+		want := Words(s)
+
+		// Reading a byte at a time ensures words and runes
+		// straddle the scanner's reads.
+		sc := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(s)))
+		sc.Split(ScanWords)
+
+		got := []string{}
+		for sc.Scan() {
+			got = append(got, sc.Text())
+		}
+		if err := sc.Err(); err != nil {
+			t.Errorf("scanning %q returned error %v.", s, err)
+		}
+		if !strSliceEqual(got, want) {
+			t.Errorf("ScanWords over %q yields %q, Words returns %q.", s, got, want)
+		}
+	}
+}
+
+func TestWordScanner(t *testing.T) {
+
+	s := strings.Repeat(`"Here's the dialogue," said the 世界 narrator/programmer! `, 500)
+	want := Words(s)
+
+	ws := NewWordScanner(strings.NewReader(s))
+	ws.Buffer(make([]byte, 7), 64)
+
+	got := []string{}
+	for ws.Scan() {
+		got = append(got, ws.Text())
+	}
+	if err := ws.Err(); err != nil {
+		t.Fatalf("WordScanner returned error %v.", err)
+	}
+	if !strSliceEqual(got, want) {
+		t.Errorf("WordScanner yields %d words, Words returns %d.", len(got), len(want))
+	}
+}
+
+func TestTokenizerSplitFunc(t *testing.T) {
+
+	tk := &Tokenizer{Grammar: DefaultGrammar, Boundaries: "–—"}
+	s := "see /usr/local/bin. or\n/etc"
+	want := tk.Words(s)
+
+	sc := bufio.NewScanner(iotest.HalfReader(strings.NewReader(s)))
+	sc.Split(tk.SplitFunc())
+
+	got := []string{}
+	for sc.Scan() {
+		got = append(got, sc.Text())
+	}
+	if !strSliceEqual(got, want) {
+		t.Errorf("Tokenizer.SplitFunc over %q yields %q, Words returns %q.", s, got, want)
+	}
+}
+
+func TestWordScannerLongRuns(t *testing.T) {
+
+	// Runs of grammar longer than the buffer are consumed as they
+	// are read rather than filling it.
+	cases := []string{
+		strings.Repeat("-", 70000),
+		"hello " + strings.Repeat("-", 70000) + " world",
+		"(" + strings.Repeat("'", 70000) + "word) end",
+	}
+
+	for _, s := range cases {
+
+		want := Words(s)
+
+		ws := NewWordScanner(strings.NewReader(s))
+		got := []string{}
+		for ws.Scan() {
+			got = append(got, ws.Text())
+		}
+		if err := ws.Err(); err != nil {
+			t.Errorf("scanning %d bytes returned error %v.", len(s), err)
+		}
+		if !strSliceEqual(got, want) {
+			t.Errorf("WordScanner over %d bytes yields %q, Words returns %q.", len(s), got, want)
+		}
+	}
+}
+
+func TestWordScannerLongWord(t *testing.T) {
+
+	// The word has grammar all the way through it, so cutting it
+	// anywhere would lose some.
+	word := strings.Repeat("ab,", 30000) + "c"
+	s := "hello " + word + " world"
+
+	ws := NewWordScanner(strings.NewReader(s))
+	got := []string{}
+	for ws.Scan() {
+		got = append(got, ws.Text())
+	}
+	if err := ws.Err(); err != bufio.ErrTooLong {
+		t.Errorf("WordScanner returned error %v, wanted %v.", err, bufio.ErrTooLong)
+	}
+	if want := []string{"hello"}; !strSliceEqual(got, want) {
+		t.Errorf("WordScanner yields %q before failing, wanted %q.", got, want)
+	}
+
+	// With a big enough buffer it is read in full.
+	ws = NewWordScanner(strings.NewReader(s))
+	ws.Buffer(nil, 1<<20)
+	got = []string{}
+	for ws.Scan() {
+		got = append(got, ws.Text())
+	}
+	if err := ws.Err(); err != nil {
+		t.Fatalf("WordScanner returned error %v.", err)
+	}
+	if want := Words(s); !strSliceEqual(got, want) {
+		t.Errorf("WordScanner yields %d words, Words returns %d.", len(got), len(want))
+	}
+}
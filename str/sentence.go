@@ -0,0 +1,215 @@
+package str
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+Sentences returns the sentences in s as a slice of strings in order
+of their appearance. Sentence boundaries follow the default rules in
+Unicode Standard Annex #29, so a full stop followed by a lowercase
+word or a number does not end a sentence, and closing quotes and
+brackets stay with the sentence they close.
+
+In addition, a full stop that ends one of a list of common English
+abbreviations such as "Dr." or "e.g." does not end a sentence.
+
+Line breaks are treated as paragraph separators and always end a
+sentence; unwrap hard-wrapped text before calling Sentences. Leading
+and trailing spaces are removed from each sentence.
+
+	ss := str.Sentences(`Dr. Smith arrived. "Is it 3.5 km?" She nodded.`)
+	// ss is []string{
+	// 	"Dr. Smith arrived.",
+	// 	`"Is it 3.5 km?"`,
+	// 	"She nodded.",
+	// }
+
+If s contains no sentences the slice will be non-nil and zero length.
+*/
+func Sentences(s string) []string {
+	ss := make([]string, 0, len(s)/80)
+	forEachSentence(s, func(sentence string) {
+		ss = append(ss, sentence)
+	})
+	return ss
+}
+
+/*
+SentenceCount returns the number of sentences in s.
+
+See Sentences for what a sentence is in this context.
+*/
+func SentenceCount(s string) int {
+	var count int
+	forEachSentence(s, func(string) {
+		count++
+	})
+	return count
+}
+
+/*
+abbreviations holds common abbreviations, lowercased, whose full
+stop does not end a sentence. Abbreviations that frequently end
+sentences, such as "etc." and "a.m.", are deliberately absent.
+*/
+var abbreviations = map[string]bool{
+	"mr.": true, "mrs.": true, "ms.": true, "mx.": true, "dr.": true,
+	"prof.": true, "sr.": true, "jr.": true, "st.": true, "mt.": true,
+	"rev.": true, "hon.": true, "gen.": true, "col.": true, "capt.": true,
+	"lt.": true, "sgt.": true, "gov.": true, "sen.": true, "rep.": true,
+	"e.g.": true, "i.e.": true, "cf.": true, "vs.": true, "viz.": true,
+	"approx.": true, "fig.": true, "figs.": true, "vol.": true, "pp.": true,
+	"ch.": true, "ed.": true, "eds.": true, "dept.": true, "univ.": true,
+	"inc.": true, "ltd.": true, "co.": true, "corp.": true, "bros.": true,
+	"ave.": true, "blvd.": true, "rd.": true, "ft.": true,
+	"jan.": true, "feb.": true, "mar.": true, "apr.": true, "jun.": true,
+	"jul.": true, "aug.": true, "sep.": true, "sept.": true, "oct.": true,
+	"nov.": true, "dec.": true,
+}
+
+type sbRune struct {
+	prop sbProp
+	pos  int
+}
+
+func sbParaSep(p sbProp) bool {
+	return p == sbSep || p == sbCR || p == sbLF
+}
+
+func sbSATerm(p sbProp) bool {
+	return p == sbATerm || p == sbSTerm
+}
+
+/*
+forEachSentence calls fn with each sentence in s, trimmed of spaces.
+Segments consisting only of spaces are skipped.
+*/
+func forEachSentence(s string, fn func(sentence string)) {
+	forEachSentenceSegment(s, func(seg string) {
+		if seg = strings.TrimSpace(seg); seg != "" {
+			fn(seg)
+		}
+	})
+}
+
+/*
+forEachSentenceSegment calls fn with each of the segments of s
+between sentence boundaries, which together make up s.
+*/
+func forEachSentenceSegment(s string, fn func(seg string)) {
+
+	// SB5: Extend and Format characters take on the
+	// property of the character they follow, so only
+	// the others are recorded.
+	rr := make([]sbRune, 0, len(s))
+	for i, r := range s {
+		p := sbOf(r)
+		if (p == sbExtend || p == sbFormat) && len(rr) > 0 && !sbParaSep(rr[len(rr)-1].prop) {
+			continue
+		}
+		rr = append(rr, sbRune{p, i})
+	}
+
+	start := 0
+	for i := 1; i < len(rr); i++ {
+		if sentenceBoundary(s, rr, i) {
+			fn(s[start:rr[i].pos])
+			start = rr[i].pos
+		}
+	}
+	if start < len(s) {
+		fn(s[start:])
+	}
+}
+
+/*
+sentenceBoundary reports whether there is a sentence boundary
+between rr[i-1] and rr[i]. The rule numbers refer to those in
+UAX #29.
+*/
+func sentenceBoundary(s string, rr []sbRune, i int) bool {
+
+	prev, next := rr[i-1].prop, rr[i].prop
+
+	switch {
+	case prev == sbCR && next == sbLF: // SB3
+		return false
+	case sbParaSep(prev): // SB4
+		return true
+	case prev == sbATerm && next == sbNumeric: // SB6
+		return false
+	case prev == sbATerm && next == sbUpper && i > 1 && (rr[i-2].prop == sbUpper || rr[i-2].prop == sbLower): // SB7
+		return false
+	}
+
+	// Look back over SATerm Close* Sp*.
+	j := i - 1
+	for j >= 0 && rr[j].prop == sbSp {
+		j--
+	}
+	spaces := j < i-1
+	for j >= 0 && rr[j].prop == sbClose {
+		j--
+	}
+	if j < 0 || !sbSATerm(rr[j].prop) {
+		return false // SB998
+	}
+	term := rr[j].prop
+
+	if term == sbATerm { // SB8
+		for k := i; k < len(rr); k++ {
+			p := rr[k].prop
+			if p == sbLower {
+				return false
+			}
+			if p == sbOLetter || p == sbUpper || sbParaSep(p) || sbSATerm(p) {
+				break
+			}
+		}
+	}
+
+	switch {
+	case next == sbSContinue || sbSATerm(next): // SB8a
+		return false
+	case !spaces && (next == sbClose || next == sbSp || sbParaSep(next)): // SB9
+		return false
+	case next == sbSp || sbParaSep(next): // SB10
+		return false
+	}
+
+	if s[rr[j].pos] == '.' && endsWithAbbreviation(s[:rr[j].pos+1]) {
+		return false
+	}
+
+	return true // SB11
+}
+
+/*
+endsWithAbbreviation reports whether the last word of s, which ends
+in a full stop, is a known abbreviation. The word is found using the
+same boundary and grammar rules as Words.
+*/
+func endsWithAbbreviation(s string) bool {
+
+	start := len(s)
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:start])
+		if defaultTokenizer.isBoundary(r) {
+			break
+		}
+		start -= size
+	}
+
+	word := s[start:]
+	for word != "" {
+		r, size := utf8.DecodeRuneInString(word)
+		if r == '.' || !defaultTokenizer.isGrammar(r) {
+			break
+		}
+		word = word[size:]
+	}
+
+	return abbreviations[strings.ToLower(word)]
+}
@@ -0,0 +1,1548 @@
+package str
+
+import "unicode"
+
+/*
+The tables in this file hold the Sentence_Break property values used
+for sentence boundary segmentation as described in Unicode Standard
+Annex #29. They are generated from Unicode 15.0's
+SentenceBreakProperty.txt.
+*/
+
+// sbProp is a Sentence_Break property value.
+type sbProp uint8
+
+const (
+	sbOther sbProp = iota
+	sbCR
+	sbLF
+	sbSep
+	sbExtend
+	sbFormat
+	sbSp
+	sbLower
+	sbUpper
+	sbOLetter
+	sbNumeric
+	sbATerm
+	sbSTerm
+	sbSContinue
+	sbClose
+)
+
+// sbTables holds the tables of each property in rough order of
+// how common they are.
+var sbTables = []struct {
+	prop  sbProp
+	table *unicode.RangeTable
+}{
+	{sbLower, sbLowerTable},
+	{sbUpper, sbUpperTable},
+	{sbOLetter, sbOLetterTable},
+	{sbSp, sbSpTable},
+	{sbExtend, sbExtendTable},
+	{sbNumeric, sbNumericTable},
+	{sbClose, sbCloseTable},
+	{sbATerm, sbATermTable},
+	{sbSTerm, sbSTermTable},
+	{sbSContinue, sbSContinueTable},
+	{sbFormat, sbFormatTable},
+	{sbSep, sbSepTable},
+}
+
+// sbOf returns the Sentence_Break property of r.
+func sbOf(r rune) sbProp {
+
+	switch r {
+	case '\r':
+		return sbCR
+	case '\n':
+		return sbLF
+	}
+
+	for _, t := range sbTables {
+		if unicode.Is(t.table, r) {
+			return t.prop
+		}
+	}
+	return sbOther
+}
+
+var sbATermTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x002E, 0x002E, 1},
+		{0x2024, 0x2024, 1},
+		{0xFE52, 0xFF0E, 188},
+	},
+	LatinOffset: 1,
+}
+
+var sbCloseTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0022, 0x0022, 1},
+		{0x0027, 0x0029, 1},
+		{0x005B, 0x005D, 2},
+		{0x007B, 0x007D, 2},
+		{0x00AB, 0x00BB, 16},
+		{0x0F3A, 0x0F3D, 1},
+		{0x169B, 0x169C, 1},
+		{0x2018, 0x201F, 1},
+		{0x2039, 0x203A, 1},
+		{0x2045, 0x2046, 1},
+		{0x207D, 0x207E, 1},
+		{0x208D, 0x208E, 1},
+		{0x2308, 0x230B, 1},
+		{0x2329, 0x232A, 1},
+		{0x275B, 0x2760, 1},
+		{0x2768, 0x2775, 1},
+		{0x27C5, 0x27C6, 1},
+		{0x27E6, 0x27EF, 1},
+		{0x2983, 0x2998, 1},
+		{0x29D8, 0x29DB, 1},
+		{0x29FC, 0x29FD, 1},
+		{0x2E00, 0x2E0D, 1},
+		{0x2E1C, 0x2E1D, 1},
+		{0x2E20, 0x2E29, 1},
+		{0x2E42, 0x2E42, 1},
+		{0x2E55, 0x2E5C, 1},
+		{0x3008, 0x3011, 1},
+		{0x3014, 0x301B, 1},
+		{0x301D, 0x301F, 1},
+		{0xFD3E, 0xFD3F, 1},
+		{0xFE17, 0xFE18, 1},
+		{0xFE35, 0xFE44, 1},
+		{0xFE47, 0xFE48, 1},
+		{0xFE59, 0xFE5E, 1},
+		{0xFF08, 0xFF09, 1},
+		{0xFF3B, 0xFF3D, 2},
+		{0xFF5B, 0xFF5D, 2},
+		{0xFF5F, 0xFF60, 1},
+		{0xFF62, 0xFF63, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1F676, 0x1F678, 1},
+	},
+	LatinOffset: 5,
+}
+
+var sbExtendTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x036F, 1},
+		{0x0483, 0x0489, 1},
+		{0x0591, 0x05BD, 1},
+		{0x05BF, 0x05BF, 1},
+		{0x05C1, 0x05C2, 1},
+		{0x05C4, 0x05C5, 1},
+		{0x05C7, 0x05C7, 1},
+		{0x0610, 0x061A, 1},
+		{0x064B, 0x065F, 1},
+		{0x0670, 0x0670, 1},
+		{0x06D6, 0x06DC, 1},
+		{0x06DF, 0x06E4, 1},
+		{0x06E7, 0x06E8, 1},
+		{0x06EA, 0x06ED, 1},
+		{0x0711, 0x0711, 1},
+		{0x0730, 0x074A, 1},
+		{0x07A6, 0x07B0, 1},
+		{0x07EB, 0x07F3, 1},
+		{0x07FD, 0x07FD, 1},
+		{0x0816, 0x0819, 1},
+		{0x081B, 0x0823, 1},
+		{0x0825, 0x0827, 1},
+		{0x0829, 0x082D, 1},
+		{0x0859, 0x085B, 1},
+		{0x0898, 0x089F, 1},
+		{0x08CA, 0x08E1, 1},
+		{0x08E3, 0x0903, 1},
+		{0x093A, 0x093C, 1},
+		{0x093E, 0x094F, 1},
+		{0x0951, 0x0957, 1},
+		{0x0962, 0x0963, 1},
+		{0x0981, 0x0983, 1},
+		{0x09BC, 0x09BC, 1},
+		{0x09BE, 0x09C4, 1},
+		{0x09C7, 0x09C8, 1},
+		{0x09CB, 0x09CD, 1},
+		{0x09D7, 0x09D7, 1},
+		{0x09E2, 0x09E3, 1},
+		{0x09FE, 0x09FE, 1},
+		{0x0A01, 0x0A03, 1},
+		{0x0A3C, 0x0A3C, 1},
+		{0x0A3E, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A51, 1},
+		{0x0A70, 0x0A71, 1},
+		{0x0A75, 0x0A75, 1},
+		{0x0A81, 0x0A83, 1},
+		{0x0ABC, 0x0ABC, 1},
+		{0x0ABE, 0x0AC5, 1},
+		{0x0AC7, 0x0AC9, 1},
+		{0x0ACB, 0x0ACD, 1},
+		{0x0AE2, 0x0AE3, 1},
+		{0x0AFA, 0x0AFF, 1},
+		{0x0B01, 0x0B03, 1},
+		{0x0B3C, 0x0B3C, 1},
+		{0x0B3E, 0x0B44, 1},
+		{0x0B47, 0x0B48, 1},
+		{0x0B4B, 0x0B4D, 1},
+		{0x0B55, 0x0B57, 1},
+		{0x0B62, 0x0B63, 1},
+		{0x0B82, 0x0B82, 1},
+		{0x0BBE, 0x0BC2, 1},
+		{0x0BC6, 0x0BC8, 1},
+		{0x0BCA, 0x0BCD, 1},
+		{0x0BD7, 0x0BD7, 1},
+		{0x0C00, 0x0C04, 1},
+		{0x0C3C, 0x0C3C, 1},
+		{0x0C3E, 0x0C44, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C62, 0x0C63, 1},
+		{0x0C81, 0x0C83, 1},
+		{0x0CBC, 0x0CBC, 1},
+		{0x0CBE, 0x0CC4, 1},
+		{0x0CC6, 0x0CC8, 1},
+		{0x0CCA, 0x0CCD, 1},
+		{0x0CD5, 0x0CD6, 1},
+		{0x0CE2, 0x0CE3, 1},
+		{0x0CF3, 0x0CF3, 1},
+		{0x0D00, 0x0D03, 1},
+		{0x0D3B, 0x0D3C, 1},
+		{0x0D3E, 0x0D44, 1},
+		{0x0D46, 0x0D48, 1},
+		{0x0D4A, 0x0D4D, 1},
+		{0x0D57, 0x0D57, 1},
+		{0x0D62, 0x0D63, 1},
+		{0x0D81, 0x0D83, 1},
+		{0x0DCA, 0x0DCA, 1},
+		{0x0DCF, 0x0DD4, 1},
+		{0x0DD6, 0x0DD6, 1},
+		{0x0DD8, 0x0DDF, 1},
+		{0x0DF2, 0x0DF3, 1},
+		{0x0E31, 0x0E31, 1},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E47, 0x0E4E, 1},
+		{0x0EB1, 0x0EB1, 1},
+		{0x0EB4, 0x0EBC, 1},
+		{0x0EC8, 0x0ECE, 1},
+		{0x0F18, 0x0F19, 1},
+		{0x0F35, 0x0F39, 2},
+		{0x0F3E, 0x0F3F, 1},
+		{0x0F71, 0x0F84, 1},
+		{0x0F86, 0x0F87, 1},
+		{0x0F8D, 0x0F97, 1},
+		{0x0F99, 0x0FBC, 1},
+		{0x0FC6, 0x0FC6, 1},
+		{0x102B, 0x103E, 1},
+		{0x1056, 0x1059, 1},
+		{0x105E, 0x1060, 1},
+		{0x1062, 0x1064, 1},
+		{0x1067, 0x106D, 1},
+		{0x1071, 0x1074, 1},
+		{0x1082, 0x108D, 1},
+		{0x108F, 0x108F, 1},
+		{0x109A, 0x109D, 1},
+		{0x135D, 0x135F, 1},
+		{0x1712, 0x1715, 1},
+		{0x1732, 0x1734, 1},
+		{0x1752, 0x1753, 1},
+		{0x1772, 0x1773, 1},
+		{0x17B4, 0x17D3, 1},
+		{0x17DD, 0x17DD, 1},
+		{0x180B, 0x180D, 1},
+		{0x180F, 0x180F, 1},
+		{0x1885, 0x1886, 1},
+		{0x18A9, 0x18A9, 1},
+		{0x1920, 0x192B, 1},
+		{0x1930, 0x193B, 1},
+		{0x1A17, 0x1A1B, 1},
+		{0x1A55, 0x1A5E, 1},
+		{0x1A60, 0x1A7C, 1},
+		{0x1A7F, 0x1A7F, 1},
+		{0x1AB0, 0x1ACE, 1},
+		{0x1B00, 0x1B04, 1},
+		{0x1B34, 0x1B44, 1},
+		{0x1B6B, 0x1B73, 1},
+		{0x1B80, 0x1B82, 1},
+		{0x1BA1, 0x1BAD, 1},
+		{0x1BE6, 0x1BF3, 1},
+		{0x1C24, 0x1C37, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CE8, 1},
+		{0x1CED, 0x1CF4, 7},
+		{0x1CF7, 0x1CF9, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x200C, 0x200D, 1},
+		{0x20D0, 0x20F0, 1},
+		{0x2CEF, 0x2CF1, 1},
+		{0x2D7F, 0x2D7F, 1},
+		{0x2DE0, 0x2DFF, 1},
+		{0x302A, 0x302F, 1},
+		{0x3099, 0x309A, 1},
+		{0xA66F, 0xA672, 1},
+		{0xA674, 0xA67D, 1},
+		{0xA69E, 0xA69F, 1},
+		{0xA6F0, 0xA6F1, 1},
+		{0xA802, 0xA806, 4},
+		{0xA80B, 0xA80B, 1},
+		{0xA823, 0xA827, 1},
+		{0xA82C, 0xA82C, 1},
+		{0xA880, 0xA881, 1},
+		{0xA8B4, 0xA8C5, 1},
+		{0xA8E0, 0xA8F1, 1},
+		{0xA8FF, 0xA8FF, 1},
+		{0xA926, 0xA92D, 1},
+		{0xA947, 0xA953, 1},
+		{0xA980, 0xA983, 1},
+		{0xA9B3, 0xA9C0, 1},
+		{0xA9E5, 0xA9E5, 1},
+		{0xAA29, 0xAA36, 1},
+		{0xAA43, 0xAA43, 1},
+		{0xAA4C, 0xAA4D, 1},
+		{0xAA7B, 0xAA7D, 1},
+		{0xAAB0, 0xAAB0, 1},
+		{0xAAB2, 0xAAB4, 1},
+		{0xAAB7, 0xAAB8, 1},
+		{0xAABE, 0xAABF, 1},
+		{0xAAC1, 0xAAC1, 1},
+		{0xAAEB, 0xAAEF, 1},
+		{0xAAF5, 0xAAF6, 1},
+		{0xABE3, 0xABEA, 1},
+		{0xABEC, 0xABED, 1},
+		{0xFB1E, 0xFB1E, 1},
+		{0xFE00, 0xFE0F, 1},
+		{0xFE20, 0xFE2F, 1},
+		{0xFF9E, 0xFF9F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101FD, 0x102E0, 227},
+		{0x10376, 0x1037A, 1},
+		{0x10A01, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A0F, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A3F, 1},
+		{0x10AE5, 0x10AE6, 1},
+		{0x10D24, 0x10D27, 1},
+		{0x10EAB, 0x10EAC, 1},
+		{0x10EFD, 0x10EFF, 1},
+		{0x10F46, 0x10F50, 1},
+		{0x10F82, 0x10F85, 1},
+		{0x11000, 0x11002, 1},
+		{0x11038, 0x11046, 1},
+		{0x11070, 0x11070, 1},
+		{0x11073, 0x11074, 1},
+		{0x1107F, 0x11082, 1},
+		{0x110B0, 0x110BA, 1},
+		{0x110C2, 0x110C2, 1},
+		{0x11100, 0x11102, 1},
+		{0x11127, 0x11134, 1},
+		{0x11145, 0x11146, 1},
+		{0x11173, 0x11173, 1},
+		{0x11180, 0x11182, 1},
+		{0x111B3, 0x111C0, 1},
+		{0x111C9, 0x111CC, 1},
+		{0x111CE, 0x111CF, 1},
+		{0x1122C, 0x11237, 1},
+		{0x1123E, 0x11241, 3},
+		{0x112DF, 0x112EA, 1},
+		{0x11300, 0x11303, 1},
+		{0x1133B, 0x1133C, 1},
+		{0x1133E, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134B, 0x1134D, 1},
+		{0x11357, 0x11357, 1},
+		{0x11362, 0x11363, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+		{0x11435, 0x11446, 1},
+		{0x1145E, 0x1145E, 1},
+		{0x114B0, 0x114C3, 1},
+		{0x115AF, 0x115B5, 1},
+		{0x115B8, 0x115C0, 1},
+		{0x115DC, 0x115DD, 1},
+		{0x11630, 0x11640, 1},
+		{0x116AB, 0x116B7, 1},
+		{0x1171D, 0x1172B, 1},
+		{0x1182C, 0x1183A, 1},
+		{0x11930, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193B, 0x1193E, 1},
+		{0x11940, 0x11940, 1},
+		{0x11942, 0x11943, 1},
+		{0x119D1, 0x119D7, 1},
+		{0x119DA, 0x119E0, 1},
+		{0x119E4, 0x119E4, 1},
+		{0x11A01, 0x11A0A, 1},
+		{0x11A33, 0x11A39, 1},
+		{0x11A3B, 0x11A3E, 1},
+		{0x11A47, 0x11A47, 1},
+		{0x11A51, 0x11A5B, 1},
+		{0x11A8A, 0x11A99, 1},
+		{0x11C2F, 0x11C36, 1},
+		{0x11C38, 0x11C3F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CA9, 0x11CB6, 1},
+		{0x11D31, 0x11D36, 1},
+		{0x11D3A, 0x11D3A, 1},
+		{0x11D3C, 0x11D3D, 1},
+		{0x11D3F, 0x11D45, 1},
+		{0x11D47, 0x11D47, 1},
+		{0x11D8A, 0x11D8E, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D93, 0x11D97, 1},
+		{0x11EF3, 0x11EF6, 1},
+		{0x11F00, 0x11F01, 1},
+		{0x11F03, 0x11F03, 1},
+		{0x11F34, 0x11F3A, 1},
+		{0x11F3E, 0x11F42, 1},
+		{0x13440, 0x13440, 1},
+		{0x13447, 0x13455, 1},
+		{0x16AF0, 0x16AF4, 1},
+		{0x16B30, 0x16B36, 1},
+		{0x16F4F, 0x16F4F, 1},
+		{0x16F51, 0x16F87, 1},
+		{0x16F8F, 0x16F92, 1},
+		{0x16FE4, 0x16FE4, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x1BC9D, 0x1BC9E, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D165, 0x1D169, 1},
+		{0x1D16D, 0x1D172, 1},
+		{0x1D17B, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+		{0x1D242, 0x1D244, 1},
+		{0x1DA00, 0x1DA36, 1},
+		{0x1DA3B, 0x1DA6C, 1},
+		{0x1DA75, 0x1DA84, 15},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+		{0x1E08F, 0x1E08F, 1},
+		{0x1E130, 0x1E136, 1},
+		{0x1E2AE, 0x1E2AE, 1},
+		{0x1E2EC, 0x1E2EF, 1},
+		{0x1E4EC, 0x1E4EF, 1},
+		{0x1E8D0, 0x1E8D6, 1},
+		{0x1E944, 0x1E94A, 1},
+		{0xE0020, 0xE007F, 1},
+		{0xE0100, 0xE01EF, 1},
+	},
+}
+
+var sbFormatTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00AD, 0x00AD, 1},
+		{0x0600, 0x0605, 1},
+		{0x061C, 0x06DD, 193},
+		{0x070F, 0x070F, 1},
+		{0x0890, 0x0891, 1},
+		{0x08E2, 0x08E2, 1},
+		{0x180E, 0x180E, 1},
+		{0x200B, 0x200B, 1},
+		{0x200E, 0x200F, 1},
+		{0x202A, 0x202E, 1},
+		{0x2060, 0x2064, 1},
+		{0x2066, 0x206F, 1},
+		{0xFEFF, 0xFEFF, 1},
+		{0xFFF9, 0xFFFB, 1},
+	},
+	R32: []unicode.Range32{
+		{0x110BD, 0x110CD, 16},
+		{0x13430, 0x1343F, 1},
+		{0x1BCA0, 0x1BCA3, 1},
+		{0x1D173, 0x1D17A, 1},
+		{0xE0001, 0xE0001, 1},
+	},
+	LatinOffset: 1,
+}
+
+var sbLowerTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0061, 0x007A, 1},
+		{0x00AA, 0x00B5, 11},
+		{0x00BA, 0x00BA, 1},
+		{0x00DF, 0x00F6, 1},
+		{0x00F8, 0x00FF, 1},
+		{0x0101, 0x0135, 2},
+		{0x0137, 0x0138, 1},
+		{0x013A, 0x0146, 2},
+		{0x0148, 0x0149, 1},
+		{0x014B, 0x0177, 2},
+		{0x017A, 0x017C, 2},
+		{0x017E, 0x0180, 1},
+		{0x0183, 0x0185, 2},
+		{0x0188, 0x0188, 1},
+		{0x018C, 0x018D, 1},
+		{0x0192, 0x0195, 3},
+		{0x0199, 0x019B, 1},
+		{0x019E, 0x01A1, 3},
+		{0x01A3, 0x01A5, 2},
+		{0x01A8, 0x01A8, 1},
+		{0x01AA, 0x01AB, 1},
+		{0x01AD, 0x01B0, 3},
+		{0x01B4, 0x01B6, 2},
+		{0x01B9, 0x01BA, 1},
+		{0x01BD, 0x01BF, 1},
+		{0x01C6, 0x01CC, 3},
+		{0x01CE, 0x01DA, 2},
+		{0x01DC, 0x01DD, 1},
+		{0x01DF, 0x01ED, 2},
+		{0x01EF, 0x01F0, 1},
+		{0x01F3, 0x01F5, 2},
+		{0x01F9, 0x0231, 2},
+		{0x0233, 0x0239, 1},
+		{0x023C, 0x023C, 1},
+		{0x023F, 0x0240, 1},
+		{0x0242, 0x0247, 5},
+		{0x0249, 0x024D, 2},
+		{0x024F, 0x0293, 1},
+		{0x0295, 0x02B8, 1},
+		{0x02C0, 0x02C1, 1},
+		{0x02E0, 0x02E4, 1},
+		{0x0371, 0x0373, 2},
+		{0x0377, 0x0377, 1},
+		{0x037A, 0x037D, 1},
+		{0x0390, 0x0390, 1},
+		{0x03AC, 0x03CE, 1},
+		{0x03D0, 0x03D1, 1},
+		{0x03D5, 0x03D7, 1},
+		{0x03D9, 0x03ED, 2},
+		{0x03EF, 0x03F3, 1},
+		{0x03F5, 0x03F8, 3},
+		{0x03FB, 0x03FC, 1},
+		{0x0430, 0x045F, 1},
+		{0x0461, 0x0481, 2},
+		{0x048B, 0x04BF, 2},
+		{0x04C2, 0x04CC, 2},
+		{0x04CE, 0x04CF, 1},
+		{0x04D1, 0x052F, 2},
+		{0x0560, 0x0588, 1},
+		{0x10FC, 0x10FC, 1},
+		{0x13F8, 0x13FD, 1},
+		{0x1C80, 0x1C88, 1},
+		{0x1D00, 0x1DBF, 1},
+		{0x1E01, 0x1E93, 2},
+		{0x1E95, 0x1E9D, 1},
+		{0x1E9F, 0x1EFD, 2},
+		{0x1EFF, 0x1F07, 1},
+		{0x1F10, 0x1F15, 1},
+		{0x1F20, 0x1F27, 1},
+		{0x1F30, 0x1F37, 1},
+		{0x1F40, 0x1F45, 1},
+		{0x1F50, 0x1F57, 1},
+		{0x1F60, 0x1F67, 1},
+		{0x1F70, 0x1F7D, 1},
+		{0x1F80, 0x1F87, 1},
+		{0x1F90, 0x1F97, 1},
+		{0x1FA0, 0x1FA7, 1},
+		{0x1FB0, 0x1FB4, 1},
+		{0x1FB6, 0x1FB7, 1},
+		{0x1FBE, 0x1FBE, 1},
+		{0x1FC2, 0x1FC4, 1},
+		{0x1FC6, 0x1FC7, 1},
+		{0x1FD0, 0x1FD3, 1},
+		{0x1FD6, 0x1FD7, 1},
+		{0x1FE0, 0x1FE7, 1},
+		{0x1FF2, 0x1FF4, 1},
+		{0x1FF6, 0x1FF7, 1},
+		{0x2071, 0x207F, 14},
+		{0x2090, 0x209C, 1},
+		{0x210A, 0x210A, 1},
+		{0x210E, 0x210F, 1},
+		{0x2113, 0x212F, 28},
+		{0x2134, 0x2139, 5},
+		{0x213C, 0x213D, 1},
+		{0x2146, 0x2149, 1},
+		{0x214E, 0x214E, 1},
+		{0x2170, 0x217F, 1},
+		{0x2184, 0x2184, 1},
+		{0x24D0, 0x24E9, 1},
+		{0x2C30, 0x2C5F, 1},
+		{0x2C61, 0x2C61, 1},
+		{0x2C65, 0x2C66, 1},
+		{0x2C68, 0x2C6C, 2},
+		{0x2C71, 0x2C71, 1},
+		{0x2C73, 0x2C74, 1},
+		{0x2C76, 0x2C7D, 1},
+		{0x2C81, 0x2CE1, 2},
+		{0x2CE3, 0x2CE4, 1},
+		{0x2CEC, 0x2CEE, 2},
+		{0x2CF3, 0x2CF3, 1},
+		{0x2D00, 0x2D25, 1},
+		{0x2D27, 0x2D2D, 6},
+		{0xA641, 0xA66D, 2},
+		{0xA681, 0xA699, 2},
+		{0xA69B, 0xA69D, 1},
+		{0xA723, 0xA72D, 2},
+		{0xA72F, 0xA731, 1},
+		{0xA733, 0xA76D, 2},
+		{0xA76F, 0xA778, 1},
+		{0xA77A, 0xA77C, 2},
+		{0xA77F, 0xA787, 2},
+		{0xA78C, 0xA78E, 2},
+		{0xA791, 0xA791, 1},
+		{0xA793, 0xA795, 1},
+		{0xA797, 0xA7A9, 2},
+		{0xA7AF, 0xA7B5, 6},
+		{0xA7B7, 0xA7C3, 2},
+		{0xA7C8, 0xA7CA, 2},
+		{0xA7D1, 0xA7D9, 2},
+		{0xA7F2, 0xA7F4, 1},
+		{0xA7F6, 0xA7F6, 1},
+		{0xA7F8, 0xA7FA, 1},
+		{0xAB30, 0xAB5A, 1},
+		{0xAB5C, 0xAB69, 1},
+		{0xAB70, 0xABBF, 1},
+		{0xFB00, 0xFB06, 1},
+		{0xFB13, 0xFB17, 1},
+		{0xFF41, 0xFF5A, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10428, 0x1044F, 1},
+		{0x104D8, 0x104FB, 1},
+		{0x10597, 0x105A1, 1},
+		{0x105A3, 0x105B1, 1},
+		{0x105B3, 0x105B9, 1},
+		{0x105BB, 0x105BC, 1},
+		{0x10780, 0x10780, 1},
+		{0x10783, 0x10785, 1},
+		{0x10787, 0x107B0, 1},
+		{0x107B2, 0x107BA, 1},
+		{0x10CC0, 0x10CF2, 1},
+		{0x118C0, 0x118DF, 1},
+		{0x16E60, 0x16E7F, 1},
+		{0x1D41A, 0x1D433, 1},
+		{0x1D44E, 0x1D454, 1},
+		{0x1D456, 0x1D467, 1},
+		{0x1D482, 0x1D49B, 1},
+		{0x1D4B6, 0x1D4B9, 1},
+		{0x1D4BB, 0x1D4BB, 1},
+		{0x1D4BD, 0x1D4C3, 1},
+		{0x1D4C5, 0x1D4CF, 1},
+		{0x1D4EA, 0x1D503, 1},
+		{0x1D51E, 0x1D537, 1},
+		{0x1D552, 0x1D56B, 1},
+		{0x1D586, 0x1D59F, 1},
+		{0x1D5BA, 0x1D5D3, 1},
+		{0x1D5EE, 0x1D607, 1},
+		{0x1D622, 0x1D63B, 1},
+		{0x1D656, 0x1D66F, 1},
+		{0x1D68A, 0x1D6A5, 1},
+		{0x1D6C2, 0x1D6DA, 1},
+		{0x1D6DC, 0x1D6E1, 1},
+		{0x1D6FC, 0x1D714, 1},
+		{0x1D716, 0x1D71B, 1},
+		{0x1D736, 0x1D74E, 1},
+		{0x1D750, 0x1D755, 1},
+		{0x1D770, 0x1D788, 1},
+		{0x1D78A, 0x1D78F, 1},
+		{0x1D7AA, 0x1D7C2, 1},
+		{0x1D7C4, 0x1D7C9, 1},
+		{0x1D7CB, 0x1D7CB, 1},
+		{0x1DF00, 0x1DF09, 1},
+		{0x1DF0B, 0x1DF1E, 1},
+		{0x1DF25, 0x1DF2A, 1},
+		{0x1E030, 0x1E06D, 1},
+		{0x1E922, 0x1E943, 1},
+	},
+	LatinOffset: 5,
+}
+
+var sbNumericTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0030, 0x0039, 1},
+		{0x0660, 0x0669, 1},
+		{0x066B, 0x066C, 1},
+		{0x06F0, 0x06F9, 1},
+		{0x07C0, 0x07C9, 1},
+		{0x0966, 0x096F, 1},
+		{0x09E6, 0x09EF, 1},
+		{0x0A66, 0x0A6F, 1},
+		{0x0AE6, 0x0AEF, 1},
+		{0x0B66, 0x0B6F, 1},
+		{0x0BE6, 0x0BEF, 1},
+		{0x0C66, 0x0C6F, 1},
+		{0x0CE6, 0x0CEF, 1},
+		{0x0D66, 0x0D6F, 1},
+		{0x0DE6, 0x0DEF, 1},
+		{0x0E50, 0x0E59, 1},
+		{0x0ED0, 0x0ED9, 1},
+		{0x0F20, 0x0F29, 1},
+		{0x1040, 0x1049, 1},
+		{0x1090, 0x1099, 1},
+		{0x17E0, 0x17E9, 1},
+		{0x1810, 0x1819, 1},
+		{0x1946, 0x194F, 1},
+		{0x19D0, 0x19D9, 1},
+		{0x1A80, 0x1A89, 1},
+		{0x1A90, 0x1A99, 1},
+		{0x1B50, 0x1B59, 1},
+		{0x1BB0, 0x1BB9, 1},
+		{0x1C40, 0x1C49, 1},
+		{0x1C50, 0x1C59, 1},
+		{0xA620, 0xA629, 1},
+		{0xA8D0, 0xA8D9, 1},
+		{0xA900, 0xA909, 1},
+		{0xA9D0, 0xA9D9, 1},
+		{0xA9F0, 0xA9F9, 1},
+		{0xAA50, 0xAA59, 1},
+		{0xABF0, 0xABF9, 1},
+		{0xFF10, 0xFF19, 1},
+	},
+	R32: []unicode.Range32{
+		{0x104A0, 0x104A9, 1},
+		{0x10D30, 0x10D39, 1},
+		{0x11066, 0x1106F, 1},
+		{0x110F0, 0x110F9, 1},
+		{0x11136, 0x1113F, 1},
+		{0x111D0, 0x111D9, 1},
+		{0x112F0, 0x112F9, 1},
+		{0x11450, 0x11459, 1},
+		{0x114D0, 0x114D9, 1},
+		{0x11650, 0x11659, 1},
+		{0x116C0, 0x116C9, 1},
+		{0x11730, 0x11739, 1},
+		{0x118E0, 0x118E9, 1},
+		{0x11950, 0x11959, 1},
+		{0x11C50, 0x11C59, 1},
+		{0x11D50, 0x11D59, 1},
+		{0x11DA0, 0x11DA9, 1},
+		{0x11F50, 0x11F59, 1},
+		{0x16A60, 0x16A69, 1},
+		{0x16AC0, 0x16AC9, 1},
+		{0x16B50, 0x16B59, 1},
+		{0x1D7CE, 0x1D7FF, 1},
+		{0x1E140, 0x1E149, 1},
+		{0x1E2F0, 0x1E2F9, 1},
+		{0x1E4F0, 0x1E4F9, 1},
+		{0x1E950, 0x1E959, 1},
+		{0x1FBF0, 0x1FBF9, 1},
+	},
+	LatinOffset: 1,
+}
+
+var sbOLetterTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x01BB, 0x01BB, 1},
+		{0x01C0, 0x01C3, 1},
+		{0x0294, 0x0294, 1},
+		{0x02B9, 0x02BF, 1},
+		{0x02C6, 0x02D1, 1},
+		{0x02EC, 0x02EE, 2},
+		{0x0374, 0x0374, 1},
+		{0x0559, 0x0559, 1},
+		{0x05D0, 0x05EA, 1},
+		{0x05EF, 0x05F3, 1},
+		{0x0620, 0x064A, 1},
+		{0x066E, 0x066F, 1},
+		{0x0671, 0x06D3, 1},
+		{0x06D5, 0x06D5, 1},
+		{0x06E5, 0x06E6, 1},
+		{0x06EE, 0x06EF, 1},
+		{0x06FA, 0x06FC, 1},
+		{0x06FF, 0x0710, 17},
+		{0x0712, 0x072F, 1},
+		{0x074D, 0x07A5, 1},
+		{0x07B1, 0x07B1, 1},
+		{0x07CA, 0x07EA, 1},
+		{0x07F4, 0x07F5, 1},
+		{0x07FA, 0x07FA, 1},
+		{0x0800, 0x0815, 1},
+		{0x081A, 0x0824, 10},
+		{0x0828, 0x0828, 1},
+		{0x0840, 0x0858, 1},
+		{0x0860, 0x086A, 1},
+		{0x0870, 0x0887, 1},
+		{0x0889, 0x088E, 1},
+		{0x08A0, 0x08C9, 1},
+		{0x0904, 0x0939, 1},
+		{0x093D, 0x0950, 19},
+		{0x0958, 0x0961, 1},
+		{0x0971, 0x0980, 1},
+		{0x0985, 0x098C, 1},
+		{0x098F, 0x0990, 1},
+		{0x0993, 0x09A8, 1},
+		{0x09AA, 0x09B0, 1},
+		{0x09B2, 0x09B2, 1},
+		{0x09B6, 0x09B9, 1},
+		{0x09BD, 0x09CE, 17},
+		{0x09DC, 0x09DD, 1},
+		{0x09DF, 0x09E1, 1},
+		{0x09F0, 0x09F1, 1},
+		{0x09FC, 0x09FC, 1},
+		{0x0A05, 0x0A0A, 1},
+		{0x0A0F, 0x0A10, 1},
+		{0x0A13, 0x0A28, 1},
+		{0x0A2A, 0x0A30, 1},
+		{0x0A32, 0x0A33, 1},
+		{0x0A35, 0x0A36, 1},
+		{0x0A38, 0x0A39, 1},
+		{0x0A59, 0x0A5C, 1},
+		{0x0A5E, 0x0A5E, 1},
+		{0x0A72, 0x0A74, 1},
+		{0x0A85, 0x0A8D, 1},
+		{0x0A8F, 0x0A91, 1},
+		{0x0A93, 0x0AA8, 1},
+		{0x0AAA, 0x0AB0, 1},
+		{0x0AB2, 0x0AB3, 1},
+		{0x0AB5, 0x0AB9, 1},
+		{0x0ABD, 0x0AD0, 19},
+		{0x0AE0, 0x0AE1, 1},
+		{0x0AF9, 0x0AF9, 1},
+		{0x0B05, 0x0B0C, 1},
+		{0x0B0F, 0x0B10, 1},
+		{0x0B13, 0x0B28, 1},
+		{0x0B2A, 0x0B30, 1},
+		{0x0B32, 0x0B33, 1},
+		{0x0B35, 0x0B39, 1},
+		{0x0B3D, 0x0B3D, 1},
+		{0x0B5C, 0x0B5D, 1},
+		{0x0B5F, 0x0B61, 1},
+		{0x0B71, 0x0B83, 18},
+		{0x0B85, 0x0B8A, 1},
+		{0x0B8E, 0x0B90, 1},
+		{0x0B92, 0x0B95, 1},
+		{0x0B99, 0x0B9A, 1},
+		{0x0B9C, 0x0B9C, 1},
+		{0x0B9E, 0x0B9F, 1},
+		{0x0BA3, 0x0BA4, 1},
+		{0x0BA8, 0x0BAA, 1},
+		{0x0BAE, 0x0BB9, 1},
+		{0x0BD0, 0x0BD0, 1},
+		{0x0C05, 0x0C0C, 1},
+		{0x0C0E, 0x0C10, 1},
+		{0x0C12, 0x0C28, 1},
+		{0x0C2A, 0x0C39, 1},
+		{0x0C3D, 0x0C3D, 1},
+		{0x0C58, 0x0C5A, 1},
+		{0x0C5D, 0x0C5D, 1},
+		{0x0C60, 0x0C61, 1},
+		{0x0C80, 0x0C80, 1},
+		{0x0C85, 0x0C8C, 1},
+		{0x0C8E, 0x0C90, 1},
+		{0x0C92, 0x0CA8, 1},
+		{0x0CAA, 0x0CB3, 1},
+		{0x0CB5, 0x0CB9, 1},
+		{0x0CBD, 0x0CBD, 1},
+		{0x0CDD, 0x0CDE, 1},
+		{0x0CE0, 0x0CE1, 1},
+		{0x0CF1, 0x0CF2, 1},
+		{0x0D04, 0x0D0C, 1},
+		{0x0D0E, 0x0D10, 1},
+		{0x0D12, 0x0D3A, 1},
+		{0x0D3D, 0x0D4E, 17},
+		{0x0D54, 0x0D56, 1},
+		{0x0D5F, 0x0D61, 1},
+		{0x0D7A, 0x0D7F, 1},
+		{0x0D85, 0x0D96, 1},
+		{0x0D9A, 0x0DB1, 1},
+		{0x0DB3, 0x0DBB, 1},
+		{0x0DBD, 0x0DBD, 1},
+		{0x0DC0, 0x0DC6, 1},
+		{0x0E01, 0x0E30, 1},
+		{0x0E32, 0x0E33, 1},
+		{0x0E40, 0x0E46, 1},
+		{0x0E81, 0x0E82, 1},
+		{0x0E84, 0x0E84, 1},
+		{0x0E86, 0x0E8A, 1},
+		{0x0E8C, 0x0EA3, 1},
+		{0x0EA5, 0x0EA5, 1},
+		{0x0EA7, 0x0EB0, 1},
+		{0x0EB2, 0x0EB3, 1},
+		{0x0EBD, 0x0EBD, 1},
+		{0x0EC0, 0x0EC4, 1},
+		{0x0EC6, 0x0EC6, 1},
+		{0x0EDC, 0x0EDF, 1},
+		{0x0F00, 0x0F00, 1},
+		{0x0F40, 0x0F47, 1},
+		{0x0F49, 0x0F6C, 1},
+		{0x0F88, 0x0F8C, 1},
+		{0x1000, 0x102A, 1},
+		{0x103F, 0x103F, 1},
+		{0x1050, 0x1055, 1},
+		{0x105A, 0x105D, 1},
+		{0x1061, 0x1061, 1},
+		{0x1065, 0x1066, 1},
+		{0x106E, 0x1070, 1},
+		{0x1075, 0x1081, 1},
+		{0x108E, 0x108E, 1},
+		{0x10D0, 0x10FA, 1},
+		{0x10FD, 0x1248, 1},
+		{0x124A, 0x124D, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x1258, 1},
+		{0x125A, 0x125D, 1},
+		{0x1260, 0x1288, 1},
+		{0x128A, 0x128D, 1},
+		{0x1290, 0x12B0, 1},
+		{0x12B2, 0x12B5, 1},
+		{0x12B8, 0x12BE, 1},
+		{0x12C0, 0x12C0, 1},
+		{0x12C2, 0x12C5, 1},
+		{0x12C8, 0x12D6, 1},
+		{0x12D8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135A, 1},
+		{0x1380, 0x138F, 1},
+		{0x1401, 0x166C, 1},
+		{0x166F, 0x167F, 1},
+		{0x1681, 0x169A, 1},
+		{0x16A0, 0x16EA, 1},
+		{0x16EE, 0x16F8, 1},
+		{0x1700, 0x1711, 1},
+		{0x171F, 0x1731, 1},
+		{0x1740, 0x1751, 1},
+		{0x1760, 0x176C, 1},
+		{0x176E, 0x1770, 1},
+		{0x1780, 0x17B3, 1},
+		{0x17D7, 0x17DC, 5},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x1884, 1},
+		{0x1887, 0x18A8, 1},
+		{0x18AA, 0x18AA, 1},
+		{0x18B0, 0x18F5, 1},
+		{0x1900, 0x191E, 1},
+		{0x1950, 0x196D, 1},
+		{0x1970, 0x1974, 1},
+		{0x1980, 0x19AB, 1},
+		{0x19B0, 0x19C9, 1},
+		{0x1A00, 0x1A16, 1},
+		{0x1A20, 0x1A54, 1},
+		{0x1AA7, 0x1AA7, 1},
+		{0x1B05, 0x1B33, 1},
+		{0x1B45, 0x1B4C, 1},
+		{0x1B83, 0x1BA0, 1},
+		{0x1BAE, 0x1BAF, 1},
+		{0x1BBA, 0x1BE5, 1},
+		{0x1C00, 0x1C23, 1},
+		{0x1C4D, 0x1C4F, 1},
+		{0x1C5A, 0x1C7D, 1},
+		{0x1C90, 0x1CBA, 1},
+		{0x1CBD, 0x1CBF, 1},
+		{0x1CE9, 0x1CEC, 1},
+		{0x1CEE, 0x1CF3, 1},
+		{0x1CF5, 0x1CF6, 1},
+		{0x1CFA, 0x1CFA, 1},
+		{0x2135, 0x2138, 1},
+		{0x2180, 0x2182, 1},
+		{0x2185, 0x2188, 1},
+		{0x2D30, 0x2D67, 1},
+		{0x2D6F, 0x2D6F, 1},
+		{0x2D80, 0x2D96, 1},
+		{0x2DA0, 0x2DA6, 1},
+		{0x2DA8, 0x2DAE, 1},
+		{0x2DB0, 0x2DB6, 1},
+		{0x2DB8, 0x2DBE, 1},
+		{0x2DC0, 0x2DC6, 1},
+		{0x2DC8, 0x2DCE, 1},
+		{0x2DD0, 0x2DD6, 1},
+		{0x2DD8, 0x2DDE, 1},
+		{0x2E2F, 0x2E2F, 1},
+		{0x3005, 0x3007, 1},
+		{0x3021, 0x3029, 1},
+		{0x3031, 0x3035, 1},
+		{0x3038, 0x303C, 1},
+		{0x3041, 0x3096, 1},
+		{0x309D, 0x309F, 1},
+		{0x30A1, 0x30FA, 1},
+		{0x30FC, 0x30FF, 1},
+		{0x3105, 0x312F, 1},
+		{0x3131, 0x318E, 1},
+		{0x31A0, 0x31BF, 1},
+		{0x31F0, 0x31FF, 1},
+		{0x3400, 0x4DBF, 1},
+		{0x4E00, 0xA48C, 1},
+		{0xA4D0, 0xA4FD, 1},
+		{0xA500, 0xA60C, 1},
+		{0xA610, 0xA61F, 1},
+		{0xA62A, 0xA62B, 1},
+		{0xA66E, 0xA67F, 17},
+		{0xA6A0, 0xA6EF, 1},
+		{0xA717, 0xA71F, 1},
+		{0xA788, 0xA78F, 7},
+		{0xA7F7, 0xA7F7, 1},
+		{0xA7FB, 0xA801, 1},
+		{0xA803, 0xA805, 1},
+		{0xA807, 0xA80A, 1},
+		{0xA80C, 0xA822, 1},
+		{0xA840, 0xA873, 1},
+		{0xA882, 0xA8B3, 1},
+		{0xA8F2, 0xA8F7, 1},
+		{0xA8FB, 0xA8FB, 1},
+		{0xA8FD, 0xA8FE, 1},
+		{0xA90A, 0xA925, 1},
+		{0xA930, 0xA946, 1},
+		{0xA960, 0xA97C, 1},
+		{0xA984, 0xA9B2, 1},
+		{0xA9CF, 0xA9CF, 1},
+		{0xA9E0, 0xA9E4, 1},
+		{0xA9E6, 0xA9EF, 1},
+		{0xA9FA, 0xA9FE, 1},
+		{0xAA00, 0xAA28, 1},
+		{0xAA40, 0xAA42, 1},
+		{0xAA44, 0xAA4B, 1},
+		{0xAA60, 0xAA76, 1},
+		{0xAA7A, 0xAA7A, 1},
+		{0xAA7E, 0xAAAF, 1},
+		{0xAAB1, 0xAAB1, 1},
+		{0xAAB5, 0xAAB6, 1},
+		{0xAAB9, 0xAABD, 1},
+		{0xAAC0, 0xAAC2, 2},
+		{0xAADB, 0xAADD, 1},
+		{0xAAE0, 0xAAEA, 1},
+		{0xAAF2, 0xAAF4, 1},
+		{0xAB01, 0xAB06, 1},
+		{0xAB09, 0xAB0E, 1},
+		{0xAB11, 0xAB16, 1},
+		{0xAB20, 0xAB26, 1},
+		{0xAB28, 0xAB2E, 1},
+		{0xABC0, 0xABE2, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xD7B0, 0xD7C6, 1},
+		{0xD7CB, 0xD7FB, 1},
+		{0xF900, 0xFA6D, 1},
+		{0xFA70, 0xFAD9, 1},
+		{0xFB1D, 0xFB1D, 1},
+		{0xFB1F, 0xFB28, 1},
+		{0xFB2A, 0xFB36, 1},
+		{0xFB38, 0xFB3C, 1},
+		{0xFB3E, 0xFB3E, 1},
+		{0xFB40, 0xFB41, 1},
+		{0xFB43, 0xFB44, 1},
+		{0xFB46, 0xFBB1, 1},
+		{0xFBD3, 0xFD3D, 1},
+		{0xFD50, 0xFD8F, 1},
+		{0xFD92, 0xFDC7, 1},
+		{0xFDF0, 0xFDFB, 1},
+		{0xFE70, 0xFE74, 1},
+		{0xFE76, 0xFEFC, 1},
+		{0xFF66, 0xFF9D, 1},
+		{0xFFA0, 0xFFBE, 1},
+		{0xFFC2, 0xFFC7, 1},
+		{0xFFCA, 0xFFCF, 1},
+		{0xFFD2, 0xFFD7, 1},
+		{0xFFDA, 0xFFDC, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x1000B, 1},
+		{0x1000D, 0x10026, 1},
+		{0x10028, 0x1003A, 1},
+		{0x1003C, 0x1003D, 1},
+		{0x1003F, 0x1004D, 1},
+		{0x10050, 0x1005D, 1},
+		{0x10080, 0x100FA, 1},
+		{0x10140, 0x10174, 1},
+		{0x10280, 0x1029C, 1},
+		{0x102A0, 0x102D0, 1},
+		{0x10300, 0x1031F, 1},
+		{0x1032D, 0x1034A, 1},
+		{0x10350, 0x10375, 1},
+		{0x10380, 0x1039D, 1},
+		{0x103A0, 0x103C3, 1},
+		{0x103C8, 0x103CF, 1},
+		{0x103D1, 0x103D5, 1},
+		{0x10450, 0x1049D, 1},
+		{0x10500, 0x10527, 1},
+		{0x10530, 0x10563, 1},
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+		{0x10781, 0x10782, 1},
+		{0x10800, 0x10805, 1},
+		{0x10808, 0x10808, 1},
+		{0x1080A, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083C, 0x1083C, 1},
+		{0x1083F, 0x10855, 1},
+		{0x10860, 0x10876, 1},
+		{0x10880, 0x1089E, 1},
+		{0x108E0, 0x108F2, 1},
+		{0x108F4, 0x108F5, 1},
+		{0x10900, 0x10915, 1},
+		{0x10920, 0x10939, 1},
+		{0x10980, 0x109B7, 1},
+		{0x109BE, 0x109BF, 1},
+		{0x10A00, 0x10A00, 1},
+		{0x10A10, 0x10A13, 1},
+		{0x10A15, 0x10A17, 1},
+		{0x10A19, 0x10A35, 1},
+		{0x10A60, 0x10A7C, 1},
+		{0x10A80, 0x10A9C, 1},
+		{0x10AC0, 0x10AC7, 1},
+		{0x10AC9, 0x10AE4, 1},
+		{0x10B00, 0x10B35, 1},
+		{0x10B40, 0x10B55, 1},
+		{0x10B60, 0x10B72, 1},
+		{0x10B80, 0x10B91, 1},
+		{0x10C00, 0x10C48, 1},
+		{0x10D00, 0x10D23, 1},
+		{0x10E80, 0x10EA9, 1},
+		{0x10EB0, 0x10EB1, 1},
+		{0x10F00, 0x10F1C, 1},
+		{0x10F27, 0x10F27, 1},
+		{0x10F30, 0x10F45, 1},
+		{0x10F70, 0x10F81, 1},
+		{0x10FB0, 0x10FC4, 1},
+		{0x10FE0, 0x10FF6, 1},
+		{0x11003, 0x11037, 1},
+		{0x11071, 0x11072, 1},
+		{0x11075, 0x11075, 1},
+		{0x11083, 0x110AF, 1},
+		{0x110D0, 0x110E8, 1},
+		{0x11103, 0x11126, 1},
+		{0x11144, 0x11147, 3},
+		{0x11150, 0x11172, 1},
+		{0x11176, 0x11176, 1},
+		{0x11183, 0x111B2, 1},
+		{0x111C1, 0x111C4, 1},
+		{0x111DA, 0x111DC, 2},
+		{0x11200, 0x11211, 1},
+		{0x11213, 0x1122B, 1},
+		{0x1123F, 0x11240, 1},
+		{0x11280, 0x11286, 1},
+		{0x11288, 0x11288, 1},
+		{0x1128A, 0x1128D, 1},
+		{0x1128F, 0x1129D, 1},
+		{0x1129F, 0x112A8, 1},
+		{0x112B0, 0x112DE, 1},
+		{0x11305, 0x1130C, 1},
+		{0x1130F, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132A, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133D, 0x11350, 19},
+		{0x1135D, 0x11361, 1},
+		{0x11400, 0x11434, 1},
+		{0x11447, 0x1144A, 1},
+		{0x1145F, 0x11461, 1},
+		{0x11480, 0x114AF, 1},
+		{0x114C4, 0x114C5, 1},
+		{0x114C7, 0x114C7, 1},
+		{0x11580, 0x115AE, 1},
+		{0x115D8, 0x115DB, 1},
+		{0x11600, 0x1162F, 1},
+		{0x11644, 0x11644, 1},
+		{0x11680, 0x116AA, 1},
+		{0x116B8, 0x116B8, 1},
+		{0x11700, 0x1171A, 1},
+		{0x11740, 0x11746, 1},
+		{0x11800, 0x1182B, 1},
+		{0x118FF, 0x11906, 1},
+		{0x11909, 0x11909, 1},
+		{0x1190C, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x1192F, 1},
+		{0x1193F, 0x11941, 2},
+		{0x119A0, 0x119A7, 1},
+		{0x119AA, 0x119D0, 1},
+		{0x119E1, 0x119E3, 2},
+		{0x11A00, 0x11A00, 1},
+		{0x11A0B, 0x11A32, 1},
+		{0x11A3A, 0x11A50, 22},
+		{0x11A5C, 0x11A89, 1},
+		{0x11A9D, 0x11A9D, 1},
+		{0x11AB0, 0x11AF8, 1},
+		{0x11C00, 0x11C08, 1},
+		{0x11C0A, 0x11C2E, 1},
+		{0x11C40, 0x11C40, 1},
+		{0x11C72, 0x11C8F, 1},
+		{0x11D00, 0x11D06, 1},
+		{0x11D08, 0x11D09, 1},
+		{0x11D0B, 0x11D30, 1},
+		{0x11D46, 0x11D46, 1},
+		{0x11D60, 0x11D65, 1},
+		{0x11D67, 0x11D68, 1},
+		{0x11D6A, 0x11D89, 1},
+		{0x11D98, 0x11D98, 1},
+		{0x11EE0, 0x11EF2, 1},
+		{0x11F02, 0x11F02, 1},
+		{0x11F04, 0x11F10, 1},
+		{0x11F12, 0x11F33, 1},
+		{0x11FB0, 0x11FB0, 1},
+		{0x12000, 0x12399, 1},
+		{0x12400, 0x1246E, 1},
+		{0x12480, 0x12543, 1},
+		{0x12F90, 0x12FF0, 1},
+		{0x13000, 0x1342F, 1},
+		{0x13441, 0x13446, 1},
+		{0x14400, 0x14646, 1},
+		{0x16800, 0x16A38, 1},
+		{0x16A40, 0x16A5E, 1},
+		{0x16A70, 0x16ABE, 1},
+		{0x16AD0, 0x16AED, 1},
+		{0x16B00, 0x16B2F, 1},
+		{0x16B40, 0x16B43, 1},
+		{0x16B63, 0x16B77, 1},
+		{0x16B7D, 0x16B8F, 1},
+		{0x16F00, 0x16F4A, 1},
+		{0x16F50, 0x16F50, 1},
+		{0x16F93, 0x16F9F, 1},
+		{0x16FE0, 0x16FE1, 1},
+		{0x16FE3, 0x16FE3, 1},
+		{0x17000, 0x187F7, 1},
+		{0x18800, 0x18CD5, 1},
+		{0x18D00, 0x18D08, 1},
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B122, 1},
+		{0x1B132, 0x1B132, 1},
+		{0x1B150, 0x1B152, 1},
+		{0x1B155, 0x1B155, 1},
+		{0x1B164, 0x1B167, 1},
+		{0x1B170, 0x1B2FB, 1},
+		{0x1BC00, 0x1BC6A, 1},
+		{0x1BC70, 0x1BC7C, 1},
+		{0x1BC80, 0x1BC88, 1},
+		{0x1BC90, 0x1BC99, 1},
+		{0x1DF0A, 0x1DF0A, 1},
+		{0x1E100, 0x1E12C, 1},
+		{0x1E137, 0x1E13D, 1},
+		{0x1E14E, 0x1E14E, 1},
+		{0x1E290, 0x1E2AD, 1},
+		{0x1E2C0, 0x1E2EB, 1},
+		{0x1E4D0, 0x1E4EB, 1},
+		{0x1E7E0, 0x1E7E6, 1},
+		{0x1E7E8, 0x1E7EB, 1},
+		{0x1E7ED, 0x1E7EE, 1},
+		{0x1E7F0, 0x1E7FE, 1},
+		{0x1E800, 0x1E8C4, 1},
+		{0x1E94B, 0x1E94B, 1},
+		{0x1EE00, 0x1EE03, 1},
+		{0x1EE05, 0x1EE1F, 1},
+		{0x1EE21, 0x1EE22, 1},
+		{0x1EE24, 0x1EE27, 3},
+		{0x1EE29, 0x1EE32, 1},
+		{0x1EE34, 0x1EE37, 1},
+		{0x1EE39, 0x1EE3B, 2},
+		{0x1EE42, 0x1EE47, 5},
+		{0x1EE49, 0x1EE4B, 2},
+		{0x1EE4D, 0x1EE4F, 1},
+		{0x1EE51, 0x1EE52, 1},
+		{0x1EE54, 0x1EE57, 3},
+		{0x1EE59, 0x1EE5F, 2},
+		{0x1EE61, 0x1EE62, 1},
+		{0x1EE64, 0x1EE64, 1},
+		{0x1EE67, 0x1EE6A, 1},
+		{0x1EE6C, 0x1EE72, 1},
+		{0x1EE74, 0x1EE77, 1},
+		{0x1EE79, 0x1EE7C, 1},
+		{0x1EE7E, 0x1EE7E, 1},
+		{0x1EE80, 0x1EE89, 1},
+		{0x1EE8B, 0x1EE9B, 1},
+		{0x1EEA1, 0x1EEA3, 1},
+		{0x1EEA5, 0x1EEA9, 1},
+		{0x1EEAB, 0x1EEBB, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x2F800, 0x2FA1D, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+}
+
+var sbSContinueTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x002C, 0x002D, 1},
+		{0x003A, 0x003A, 1},
+		{0x055D, 0x055D, 1},
+		{0x060C, 0x060D, 1},
+		{0x07F8, 0x07F8, 1},
+		{0x1802, 0x1808, 6},
+		{0x2013, 0x2014, 1},
+		{0x3001, 0x3001, 1},
+		{0xFE10, 0xFE11, 1},
+		{0xFE13, 0xFE13, 1},
+		{0xFE31, 0xFE32, 1},
+		{0xFE50, 0xFE51, 1},
+		{0xFE55, 0xFE58, 3},
+		{0xFE63, 0xFE63, 1},
+		{0xFF0C, 0xFF0D, 1},
+		{0xFF1A, 0xFF64, 74},
+	},
+	LatinOffset: 2,
+}
+
+var sbSTermTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0021, 0x003F, 30},
+		{0x0589, 0x0589, 1},
+		{0x061D, 0x061F, 1},
+		{0x06D4, 0x06D4, 1},
+		{0x0700, 0x0702, 1},
+		{0x07F9, 0x0837, 62},
+		{0x0839, 0x0839, 1},
+		{0x083D, 0x083E, 1},
+		{0x0964, 0x0965, 1},
+		{0x104A, 0x104B, 1},
+		{0x1362, 0x1362, 1},
+		{0x1367, 0x1368, 1},
+		{0x166E, 0x166E, 1},
+		{0x1735, 0x1736, 1},
+		{0x1803, 0x1809, 6},
+		{0x1944, 0x1945, 1},
+		{0x1AA8, 0x1AAB, 1},
+		{0x1B5A, 0x1B5B, 1},
+		{0x1B5E, 0x1B5F, 1},
+		{0x1B7D, 0x1B7E, 1},
+		{0x1C3B, 0x1C3C, 1},
+		{0x1C7E, 0x1C7F, 1},
+		{0x203C, 0x203D, 1},
+		{0x2047, 0x2049, 1},
+		{0x2E2E, 0x2E3C, 14},
+		{0x2E53, 0x2E54, 1},
+		{0x3002, 0x3002, 1},
+		{0xA4FF, 0xA4FF, 1},
+		{0xA60E, 0xA60F, 1},
+		{0xA6F3, 0xA6F7, 4},
+		{0xA876, 0xA877, 1},
+		{0xA8CE, 0xA8CF, 1},
+		{0xA92F, 0xA92F, 1},
+		{0xA9C8, 0xA9C9, 1},
+		{0xAA5D, 0xAA5F, 1},
+		{0xAAF0, 0xAAF1, 1},
+		{0xABEB, 0xABEB, 1},
+		{0xFE56, 0xFE57, 1},
+		{0xFF01, 0xFF1F, 30},
+		{0xFF61, 0xFF61, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10A56, 0x10A57, 1},
+		{0x10F55, 0x10F59, 1},
+		{0x10F86, 0x10F89, 1},
+		{0x11047, 0x11048, 1},
+		{0x110BE, 0x110C1, 1},
+		{0x11141, 0x11143, 1},
+		{0x111C5, 0x111C6, 1},
+		{0x111CD, 0x111CD, 1},
+		{0x111DE, 0x111DF, 1},
+		{0x11238, 0x11239, 1},
+		{0x1123B, 0x1123C, 1},
+		{0x112A9, 0x112A9, 1},
+		{0x1144B, 0x1144C, 1},
+		{0x115C2, 0x115C3, 1},
+		{0x115C9, 0x115D7, 1},
+		{0x11641, 0x11642, 1},
+		{0x1173C, 0x1173E, 1},
+		{0x11944, 0x11946, 2},
+		{0x11A42, 0x11A43, 1},
+		{0x11A9B, 0x11A9C, 1},
+		{0x11C41, 0x11C42, 1},
+		{0x11EF7, 0x11EF8, 1},
+		{0x11F43, 0x11F44, 1},
+		{0x16A6E, 0x16A6F, 1},
+		{0x16AF5, 0x16AF5, 1},
+		{0x16B37, 0x16B38, 1},
+		{0x16B44, 0x16B44, 1},
+		{0x16E98, 0x16E98, 1},
+		{0x1BC9F, 0x1BC9F, 1},
+		{0x1DA88, 0x1DA88, 1},
+	},
+	LatinOffset: 1,
+}
+
+var sbSepTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0085, 0x0085, 1},
+		{0x2028, 0x2029, 1},
+	},
+	LatinOffset: 1,
+}
+
+var sbSpTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0009, 0x0009, 1},
+		{0x000B, 0x000C, 1},
+		{0x0020, 0x00A0, 128},
+		{0x1680, 0x1680, 1},
+		{0x2000, 0x200A, 1},
+		{0x202F, 0x205F, 48},
+		{0x3000, 0x3000, 1},
+	},
+	LatinOffset: 3,
+}
+
+var sbUpperTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0041, 0x005A, 1},
+		{0x00C0, 0x00D6, 1},
+		{0x00D8, 0x00DE, 1},
+		{0x0100, 0x0136, 2},
+		{0x0139, 0x0147, 2},
+		{0x014A, 0x0176, 2},
+		{0x0178, 0x0179, 1},
+		{0x017B, 0x017D, 2},
+		{0x0181, 0x0182, 1},
+		{0x0184, 0x0184, 1},
+		{0x0186, 0x0187, 1},
+		{0x0189, 0x018B, 1},
+		{0x018E, 0x0191, 1},
+		{0x0193, 0x0194, 1},
+		{0x0196, 0x0198, 1},
+		{0x019C, 0x019D, 1},
+		{0x019F, 0x01A0, 1},
+		{0x01A2, 0x01A4, 2},
+		{0x01A6, 0x01A7, 1},
+		{0x01A9, 0x01AC, 3},
+		{0x01AE, 0x01AF, 1},
+		{0x01B1, 0x01B3, 1},
+		{0x01B5, 0x01B5, 1},
+		{0x01B7, 0x01B8, 1},
+		{0x01BC, 0x01BC, 1},
+		{0x01C4, 0x01C5, 1},
+		{0x01C7, 0x01C8, 1},
+		{0x01CA, 0x01CB, 1},
+		{0x01CD, 0x01DB, 2},
+		{0x01DE, 0x01EE, 2},
+		{0x01F1, 0x01F2, 1},
+		{0x01F4, 0x01F4, 1},
+		{0x01F6, 0x01F8, 1},
+		{0x01FA, 0x0232, 2},
+		{0x023A, 0x023B, 1},
+		{0x023D, 0x023E, 1},
+		{0x0241, 0x0241, 1},
+		{0x0243, 0x0246, 1},
+		{0x0248, 0x024E, 2},
+		{0x0370, 0x0372, 2},
+		{0x0376, 0x037F, 9},
+		{0x0386, 0x0386, 1},
+		{0x0388, 0x038A, 1},
+		{0x038C, 0x038C, 1},
+		{0x038E, 0x038F, 1},
+		{0x0391, 0x03A1, 1},
+		{0x03A3, 0x03AB, 1},
+		{0x03CF, 0x03CF, 1},
+		{0x03D2, 0x03D4, 1},
+		{0x03D8, 0x03EE, 2},
+		{0x03F4, 0x03F7, 3},
+		{0x03F9, 0x03FA, 1},
+		{0x03FD, 0x042F, 1},
+		{0x0460, 0x0480, 2},
+		{0x048A, 0x04BE, 2},
+		{0x04C0, 0x04C1, 1},
+		{0x04C3, 0x04CD, 2},
+		{0x04D0, 0x052E, 2},
+		{0x0531, 0x0556, 1},
+		{0x10A0, 0x10C5, 1},
+		{0x10C7, 0x10CD, 6},
+		{0x13A0, 0x13F5, 1},
+		{0x1E00, 0x1E94, 2},
+		{0x1E9E, 0x1EFE, 2},
+		{0x1F08, 0x1F0F, 1},
+		{0x1F18, 0x1F1D, 1},
+		{0x1F28, 0x1F2F, 1},
+		{0x1F38, 0x1F3F, 1},
+		{0x1F48, 0x1F4D, 1},
+		{0x1F59, 0x1F5F, 2},
+		{0x1F68, 0x1F6F, 1},
+		{0x1F88, 0x1F8F, 1},
+		{0x1F98, 0x1F9F, 1},
+		{0x1FA8, 0x1FAF, 1},
+		{0x1FB8, 0x1FBC, 1},
+		{0x1FC8, 0x1FCC, 1},
+		{0x1FD8, 0x1FDB, 1},
+		{0x1FE8, 0x1FEC, 1},
+		{0x1FF8, 0x1FFC, 1},
+		{0x2102, 0x2107, 5},
+		{0x210B, 0x210D, 1},
+		{0x2110, 0x2112, 1},
+		{0x2115, 0x2115, 1},
+		{0x2119, 0x211D, 1},
+		{0x2124, 0x2128, 2},
+		{0x212A, 0x212D, 1},
+		{0x2130, 0x2133, 1},
+		{0x213E, 0x213F, 1},
+		{0x2145, 0x2145, 1},
+		{0x2160, 0x216F, 1},
+		{0x2183, 0x2183, 1},
+		{0x24B6, 0x24CF, 1},
+		{0x2C00, 0x2C2F, 1},
+		{0x2C60, 0x2C60, 1},
+		{0x2C62, 0x2C64, 1},
+		{0x2C67, 0x2C6B, 2},
+		{0x2C6D, 0x2C70, 1},
+		{0x2C72, 0x2C75, 3},
+		{0x2C7E, 0x2C80, 1},
+		{0x2C82, 0x2CE2, 2},
+		{0x2CEB, 0x2CED, 2},
+		{0x2CF2, 0x2CF2, 1},
+		{0xA640, 0xA66C, 2},
+		{0xA680, 0xA69A, 2},
+		{0xA722, 0xA72E, 2},
+		{0xA732, 0xA76E, 2},
+		{0xA779, 0xA77B, 2},
+		{0xA77D, 0xA77E, 1},
+		{0xA780, 0xA786, 2},
+		{0xA78B, 0xA78D, 2},
+		{0xA790, 0xA792, 2},
+		{0xA796, 0xA7A8, 2},
+		{0xA7AA, 0xA7AE, 1},
+		{0xA7B0, 0xA7B4, 1},
+		{0xA7B6, 0xA7C2, 2},
+		{0xA7C4, 0xA7C7, 1},
+		{0xA7C9, 0xA7D0, 7},
+		{0xA7D6, 0xA7D8, 2},
+		{0xA7F5, 0xA7F5, 1},
+		{0xFF21, 0xFF3A, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10400, 0x10427, 1},
+		{0x104B0, 0x104D3, 1},
+		{0x10570, 0x1057A, 1},
+		{0x1057C, 0x1058A, 1},
+		{0x1058C, 0x10592, 1},
+		{0x10594, 0x10595, 1},
+		{0x10C80, 0x10CB2, 1},
+		{0x118A0, 0x118BF, 1},
+		{0x16E40, 0x16E5F, 1},
+		{0x1D400, 0x1D419, 1},
+		{0x1D434, 0x1D44D, 1},
+		{0x1D468, 0x1D481, 1},
+		{0x1D49C, 0x1D49C, 1},
+		{0x1D49E, 0x1D49F, 1},
+		{0x1D4A2, 0x1D4A2, 1},
+		{0x1D4A5, 0x1D4A6, 1},
+		{0x1D4A9, 0x1D4AC, 1},
+		{0x1D4AE, 0x1D4B5, 1},
+		{0x1D4D0, 0x1D4E9, 1},
+		{0x1D504, 0x1D505, 1},
+		{0x1D507, 0x1D50A, 1},
+		{0x1D50D, 0x1D514, 1},
+		{0x1D516, 0x1D51C, 1},
+		{0x1D538, 0x1D539, 1},
+		{0x1D53B, 0x1D53E, 1},
+		{0x1D540, 0x1D544, 1},
+		{0x1D546, 0x1D546, 1},
+		{0x1D54A, 0x1D550, 1},
+		{0x1D56C, 0x1D585, 1},
+		{0x1D5A0, 0x1D5B9, 1},
+		{0x1D5D4, 0x1D5ED, 1},
+		{0x1D608, 0x1D621, 1},
+		{0x1D63C, 0x1D655, 1},
+		{0x1D670, 0x1D689, 1},
+		{0x1D6A8, 0x1D6C0, 1},
+		{0x1D6E2, 0x1D6FA, 1},
+		{0x1D71C, 0x1D734, 1},
+		{0x1D756, 0x1D76E, 1},
+		{0x1D790, 0x1D7A8, 1},
+		{0x1D7CA, 0x1D7CA, 1},
+		{0x1E900, 0x1E921, 1},
+		{0x1F130, 0x1F149, 1},
+		{0x1F150, 0x1F169, 1},
+		{0x1F170, 0x1F189, 1},
+	},
+	LatinOffset: 3,
+}
@@ -0,0 +1,91 @@
+package str
+
+import "testing"
+
+func TestSentences(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want []string
+	}{
+		{
+			"Hello there. How are you? I'm fine!",
+			[]string{"Hello there.", "How are you?", "I'm fine!"},
+		},
+		{
+			`Dr. Smith arrived. "Is it 3.5 km?" She nodded.`,
+			[]string{"Dr. Smith arrived.", `"Is it 3.5 km?"`, "She nodded."},
+		},
+		{
+			"Use a tool, e.g. a hammer. Then stop.",
+			[]string{"Use a tool, e.g. a hammer.", "Then stop."},
+		},
+		{
+			"See Fig. 3 for details. (It's big.) Next.",
+			[]string{"See Fig. 3 for details.", "(It's big.)", "Next."},
+		},
+		{
+			"He said “stop.” She didn't.",
+			[]string{"He said “stop.”", "She didn't."},
+		},
+		{
+			"Wait... what?! No.",
+			[]string{"Wait... what?!", "No."},
+		},
+		{
+			"etc. and so on.",
+			[]string{"etc. and so on."},
+		},
+		{
+			"First line\nSecond line.\n\nThird.",
+			[]string{"First line", "Second line.", "Third."},
+		},
+		{
+			"你好。世界！",
+			[]string{"你好。", "世界！"},
+		},
+		{
+			"გამარჯობა. როგორ ხარ?",
+			[]string{"გამარჯობა.", "როგორ ხარ?"},
+		},
+		{
+			"Yes!\u102b No.",
+			[]string{"Yes!\u102b", "No."},
+		},
+		{
+			"Hi.\u200b There.",
+			[]string{"Hi.\u200b", "There."},
+		},
+		{
+			"Really?🏽 Yes.",
+			[]string{"Really?", "🏽 Yes."},
+		},
+		{"", []string{}},
+		{"  \n\n ", []string{}},
+	}
+
+	for _, c := range cases {
+		if got := Sentences(c.s); !strSliceEqual(got, c.want) {
+			t.Errorf("Sentences(%q)\n    return %q\n    wanted %q.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestSentenceCount(t *testing.T) {
+
+	cases := []struct {
+		want int
+		s    string
+	}{
+		{3, "Hello there. How are you? I'm fine!"},
+		{2, "Mr. and Mrs. Smith left. They were tired."},
+		{1, "No full stop"},
+		{0, ""},
+	}
+
+	for _, c := range cases {
+		if got := SentenceCount(c.s); got != c.want {
+			t.Errorf("SentenceCount(%q) return %d, wanted %d.", c.s, got, c.want)
+		}
+	}
+}
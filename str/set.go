@@ -0,0 +1,138 @@
+package str
+
+/*
+Set is an unordered collection of distinct values with constant time
+membership tests. Slices returned by functions such as CharSet and
+WordSet can be turned into a Set with NewSet:
+
+	words := str.NewSet(str.WordSet(s, true)...)
+	if words.Contains("hello") {
+		// ...
+	}
+
+The zero value is an empty set ready to use. A nil *Set contains
+nothing.
+*/
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+/*
+NewSet returns a Set containing items.
+*/
+func NewSet[T comparable](items ...T) *Set[T] {
+	set := &Set[T]{}
+	set.Add(items...)
+	return set
+}
+
+/*
+Add adds items to set.
+*/
+func (set *Set[T]) Add(items ...T) {
+	if set.items == nil {
+		set.items = make(map[T]struct{}, len(items))
+	}
+	for _, item := range items {
+		set.items[item] = struct{}{}
+	}
+}
+
+/*
+Remove removes items from set.
+*/
+func (set *Set[T]) Remove(items ...T) {
+	if set == nil {
+		return
+	}
+	for _, item := range items {
+		delete(set.items, item)
+	}
+}
+
+/*
+Contains reports whether item is in set.
+*/
+func (set *Set[T]) Contains(item T) bool {
+	if set == nil {
+		return false
+	}
+	_, ok := set.items[item]
+	return ok
+}
+
+/*
+Len returns the number of items in set.
+*/
+func (set *Set[T]) Len() int {
+	if set == nil {
+		return 0
+	}
+	return len(set.items)
+}
+
+/*
+Items returns the items in set in no particular order.
+*/
+func (set *Set[T]) Items() []T {
+	items := make([]T, 0, set.Len())
+	if set != nil {
+		for item := range set.items {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+/*
+Union returns a new Set containing the items that are in either set
+or other.
+*/
+func (set *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := &Set[T]{items: make(map[T]struct{}, set.Len()+other.Len())}
+	for _, s := range []*Set[T]{set, other} {
+		if s == nil {
+			continue
+		}
+		for item := range s.items {
+			union.items[item] = struct{}{}
+		}
+	}
+	return union
+}
+
+/*
+Intersection returns a new Set containing the items that are in both
+set and other.
+*/
+func (set *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, large := set, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	inter := &Set[T]{items: make(map[T]struct{}, small.Len())}
+	if small != nil {
+		for item := range small.items {
+			if large.Contains(item) {
+				inter.items[item] = struct{}{}
+			}
+		}
+	}
+	return inter
+}
+
+/*
+Difference returns a new Set containing the items that are in set
+but not in other.
+*/
+func (set *Set[T]) Difference(other *Set[T]) *Set[T] {
+	diff := &Set[T]{items: make(map[T]struct{}, set.Len())}
+	if set != nil {
+		for item := range set.items {
+			if !other.Contains(item) {
+				diff.items[item] = struct{}{}
+			}
+		}
+	}
+	return diff
+}
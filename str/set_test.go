@@ -0,0 +1,79 @@
+package str
+
+import (
+	"sort"
+	"testing"
+)
+
+func sortedItems(set *Set[string]) []string {
+	items := set.Items()
+	sort.Strings(items)
+	return items
+}
+
+func TestSet(t *testing.T) {
+
+	set := NewSet(WordSet("the cat sat on the mat", false)...)
+	if got := set.Len(); got != 5 {
+		t.Errorf("Len() return %d, wanted 5.", got)
+	}
+	for _, w := range []string{"the", "cat", "mat"} {
+		if !set.Contains(w) {
+			t.Errorf("Contains(%q) return false, wanted true.", w)
+		}
+	}
+	if set.Contains("dog") {
+		t.Errorf("Contains(%q) return true, wanted false.", "dog")
+	}
+
+	set.Remove("the", "dog")
+	set.Add("hat")
+	want := []string{"cat", "hat", "mat", "on", "sat"}
+	if got := sortedItems(set); !strSliceEqual(got, want) {
+		t.Errorf("Items() return %s, wanted %s.", quoteSlice(got), quoteSlice(want))
+	}
+
+	var zero Set[rune]
+	zero.Add('a')
+	if !zero.Contains('a') || zero.Len() != 1 {
+		t.Errorf("zero value Set does not hold added item.")
+	}
+
+	var nilSet *Set[string]
+	if nilSet.Contains("a") || nilSet.Len() != 0 || len(nilSet.Items()) != 0 {
+		t.Errorf("nil Set is not empty.")
+	}
+	nilSet.Remove("a")
+}
+
+func TestSetOperations(t *testing.T) {
+
+	a := NewSet(CharSet("abcd", false)...)
+	b := NewSet(CharSet("CDEF", true)...)
+	var empty *Set[string]
+
+	cases := []struct {
+		name string
+		got  *Set[string]
+		want []string
+	}{
+		{"Union", a.Union(b), []string{"a", "b", "c", "d", "e", "f"}},
+		{"Intersection", a.Intersection(b), []string{"c", "d"}},
+		{"Difference", a.Difference(b), []string{"a", "b"}},
+		{"Difference", b.Difference(a), []string{"e", "f"}},
+		{"Union", a.Union(empty), []string{"a", "b", "c", "d"}},
+		{"Intersection", empty.Intersection(a), []string{}},
+		{"Difference", empty.Difference(a), []string{}},
+	}
+
+	for _, c := range cases {
+		if got := sortedItems(c.got); !strSliceEqual(got, c.want) {
+			t.Errorf("%s return %s, wanted %s.", c.name, quoteSlice(got), quoteSlice(c.want))
+		}
+	}
+
+	// Operations return new sets.
+	if a.Len() != 4 || b.Len() != 4 {
+		t.Errorf("set operations modified their operands.")
+	}
+}
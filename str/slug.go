@@ -0,0 +1,163 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+ToASCII transliterates s to ASCII. Latin letters with diacritics
+lose them, Greek and Cyrillic letters are romanised letter by letter,
+and common punctuation and symbols are replaced with ASCII
+equivalents, such as curly quotes with straight ones and "€" with
+"EUR". Other characters are replaced by the ASCII runes of their
+compatibility decomposition (see NFKD), so "ﬁ" becomes "fi" and "①"
+becomes "1". Non-ASCII spaces become ordinary spaces.
+
+Combining marks and any other runes without a transliteration are
+removed, so the result depends only on s.
+
+	a := str.ToASCII("Crème brûlée")  // "Creme brulee"
+	a := str.ToASCII("Ελληνικά")      // "Ellinika"
+	a := str.ToASCII("Достоевский")   // "Dostoevskiy"
+	a := str.ToASCII("“5 €”")         // `"5 EUR"`
+*/
+func ToASCII(s string) string {
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range s {
+		switch {
+		case r < 0x80:
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteByte(' ')
+		default:
+			if a, ok := asciiTable[r]; ok {
+				b.WriteString(a)
+				break
+			}
+
+			// Otherwise keep what's transliterable of the
+			// rune's compatibility decomposition, which
+			// covers the likes of "ṣ", "ﬁ" and "①".
+			for _, d := range decompose(r, true) {
+				if d < utf8.RuneSelf {
+					b.WriteRune(d)
+				} else {
+					b.WriteString(asciiTable[d])
+				}
+			}
+		}
+	}
+
+	return b.String()
+}
+
+/*
+SlugOptions configures Slugify. The zero value produces lowercase
+slugs of any length separated by hyphens.
+*/
+type SlugOptions struct {
+	// Separator is placed between words. It defaults to "-".
+	Separator string
+
+	// MaxLength, if greater than zero, is the maximum length of
+	// the slug in bytes. Whole words are dropped from the end to
+	// meet it; only a first word that is too long by itself is
+	// cut short.
+	MaxLength int
+
+	// KeepCase stops the slug being lowercased.
+	KeepCase bool
+}
+
+/*
+Slugify returns a version of s suitable for use in URLs and file
+names. The words in s, as found by Words, are transliterated with
+ToASCII and joined with opts.Separator. Any runs of characters other
+than ASCII letters and digits within a word are replaced by the
+separator too, while apostrophes are removed.
+
+	s := str.Slugify("Crème Brûlée: A Recipe!", str.SlugOptions{})
+	// s is "creme-brulee-a-recipe"
+
+	s := str.Slugify("Don't stop—e-mail me", str.SlugOptions{MaxLength: 14})
+	// s is "dont-stop"
+*/
+func Slugify(s string, opts SlugOptions) string {
+
+	sep := opts.Separator
+	if sep == "" {
+		sep = "-"
+	}
+
+	var slug strings.Builder
+	for _, w := range Words(s) {
+
+		w = ToASCII(w)
+		if !opts.KeepCase {
+			w = strings.ToLower(w)
+		}
+
+		piece := slugPiece(w, sep)
+		if piece == "" {
+			continue
+		}
+
+		n := len(piece)
+		if slug.Len() > 0 {
+			n += len(sep)
+		}
+
+		if opts.MaxLength > 0 && slug.Len()+n > opts.MaxLength {
+			if slug.Len() == 0 {
+				slug.WriteString(strings.TrimRightFunc(piece[:opts.MaxLength], func(r rune) bool {
+					return !isASCIIAlnum(byte(r))
+				}))
+			}
+			break
+		}
+
+		if slug.Len() > 0 {
+			slug.WriteString(sep)
+		}
+		slug.WriteString(piece)
+	}
+
+	return slug.String()
+}
+
+/*
+slugPiece returns w, which must be ASCII, with apostrophes removed
+and every run of other characters that aren't letters or digits
+replaced by sep. Leading and trailing runs are removed.
+*/
+func slugPiece(w, sep string) string {
+
+	var b strings.Builder
+	pending := false
+
+	for i := 0; i < len(w); i++ {
+		c := w[i]
+		switch {
+		case isASCIIAlnum(c):
+			if pending && b.Len() > 0 {
+				b.WriteString(sep)
+			}
+			pending = false
+			b.WriteByte(c)
+		case c == '\'':
+		default:
+			pending = true
+		}
+	}
+
+	return b.String()
+}
+
+func isASCIIAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,77 @@
+package str
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestToASCII(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want string
+	}{
+		{"Crème brûlée", "Creme brulee"},
+		{"Łódź", "Lodz"},
+		{"Ærøskøbing", "AEroskobing"},
+		{"Straße", "Strasse"},
+		{"Ștefan Țiriac", "Stefan Tiriac"},
+		{"Nguyễn Tấn Dũng", "Nguyen Tan Dung"},
+		{"Ελληνικά", "Ellinika"},
+		{"Достоевский", "Dostoevskiy"},
+		{"Їжак", "Yizhak"},
+		{"café", "cafe"},
+		{"cafe\u0301", "cafe"},
+		{"“5 €”", `"5 EUR"`},
+		{"a b…", "a b..."},
+		{"Ⓐ世界", "A"},
+		{"ﬁrst ṣ ①", "first s 1"},
+		{"Ａｌｐｈａ", "Alpha"},
+		{"plain ASCII!", "plain ASCII!"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := ToASCII(c.s); got != c.want {
+			t.Errorf("ToASCII(%q) return %q, wanted %q.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestASCIITable(t *testing.T) {
+	for r, a := range asciiTable {
+		for i := 0; i < len(a); i++ {
+			if a[i] >= utf8.RuneSelf {
+				t.Errorf("asciiTable[%q] is %q, which is not ASCII.", r, a)
+				break
+			}
+		}
+	}
+}
+
+func TestSlugify(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		opts SlugOptions
+		want string
+	}{
+		{"Crème Brûlée: A Recipe!", SlugOptions{}, "creme-brulee-a-recipe"},
+		{"Don't stop—e-mail me", SlugOptions{}, "dont-stop-e-mail-me"},
+		{"Don’t stop—e-mail me", SlugOptions{MaxLength: 14}, "dont-stop"},
+		{"Don't stop—e-mail me", SlugOptions{MaxLength: 16}, "dont-stop-e-mail"},
+		{"Supercalifragilistic word", SlugOptions{MaxLength: 5}, "super"},
+		{"e-mail address", SlugOptions{MaxLength: 2}, "e"},
+		{"Hello   World", SlugOptions{Separator: "_", KeepCase: true}, "Hello_World"},
+		{"Привет, мир", SlugOptions{}, "privet-mir"},
+		{"C++ & Go (2024)", SlugOptions{}, "c-go-2024"},
+		{"  --  ", SlugOptions{}, ""},
+		{"", SlugOptions{}, ""},
+	}
+
+	for _, c := range cases {
+		if got := Slugify(c.s, c.opts); got != c.want {
+			t.Errorf("Slugify(%q, %+v) return %q, wanted %q.", c.s, c.opts, got, c.want)
+		}
+	}
+}
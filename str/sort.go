@@ -0,0 +1,128 @@
+package str
+
+import (
+	"container/heap"
+	"sort"
+)
+
+/*
+Order is the direction in which an OccMap is sorted.
+*/
+type Order int
+
+const (
+	Descending Order = iota
+	Ascending
+)
+
+/*
+SortByCount sorts om in place by the number of occurrences of each
+substring in the order o. Substrings with the same number of
+occurrences are sorted in ascending byte-wise order regardless of o,
+so the result is always deterministic.
+
+	om := str.WordsByOccurrence("b a c a", false)
+	om.SortByCount(str.Descending)
+	// om is OccMap{{"a", 2}, {"b", 1}, {"c", 1}}
+*/
+func (om OccMap) SortByCount(o Order) {
+	sort.Slice(om, func(i, j int) bool {
+		if om[i].N != om[j].N {
+			if o == Ascending {
+				return om[i].N < om[j].N
+			}
+			return om[i].N > om[j].N
+		}
+		return om[i].SubStr < om[j].SubStr
+	})
+}
+
+/*
+SortAlpha sorts om in place by substring in byte-wise order, which
+for UTF-8 is the same as ordering by code point, in the order o.
+*/
+func (om OccMap) SortAlpha(o Order) {
+	sort.Slice(om, func(i, j int) bool {
+		if o == Ascending {
+			return om[i].SubStr < om[j].SubStr
+		}
+		return om[i].SubStr > om[j].SubStr
+	})
+}
+
+/*
+SortBy sorts om in place using less, which reports whether a should
+sort before b. The sort is stable, so multi-key orderings can be
+built by sorting on the least significant key first:
+
+	// By length, then alphabetically.
+	om.SortAlpha(str.Ascending)
+	om.SortBy(func(a, b str.Occurrences) bool {
+		return str.Len(a.SubStr) < str.Len(b.SubStr)
+	})
+*/
+func (om OccMap) SortBy(less func(a, b Occurrences) bool) {
+	sort.SliceStable(om, func(i, j int) bool {
+		return less(om[i], om[j])
+	})
+}
+
+/*
+TopK returns the k most frequent substrings in om, ordered as they
+would be by SortByCount(Descending). It uses a heap of size k so is
+considerably faster than sorting om when k is small. om is not
+modified. If k is greater than the length of om all of om is
+returned, sorted.
+*/
+func (om OccMap) TopK(k int) OccMap {
+
+	if k <= 0 {
+		return OccMap{}
+	}
+
+	// h is a min-heap, so the least frequent of the
+	// current top k is always at the root.
+	h := make(occHeap, 0, min(k, len(om)))
+	for _, o := range om {
+		if len(h) < k {
+			heap.Push(&h, o)
+			continue
+		}
+		if occBefore(o, h[0]) {
+			h[0] = o
+			heap.Fix(&h, 0)
+		}
+	}
+
+	top := make(OccMap, len(h))
+	for i := len(h) - 1; i >= 0; i-- {
+		top[i] = heap.Pop(&h).(Occurrences)
+	}
+	return top
+}
+
+// occBefore reports whether a sorts before b in descending
+// order of occurrence.
+func occBefore(a, b Occurrences) bool {
+	if a.N != b.N {
+		return a.N > b.N
+	}
+	return a.SubStr < b.SubStr
+}
+
+type occHeap []Occurrences
+
+func (h occHeap) Len() int           { return len(h) }
+func (h occHeap) Less(i, j int) bool { return occBefore(h[j], h[i]) }
+func (h occHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *occHeap) Push(x any) {
+	*h = append(*h, x.(Occurrences))
+}
+
+func (h *occHeap) Pop() any {
+	old := *h
+	o := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return o
+}
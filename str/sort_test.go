@@ -0,0 +1,99 @@
+package str
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func occMapIdentical(om1, om2 OccMap) bool {
+	if len(om1) != len(om2) {
+		return false
+	}
+	for i := range om1 {
+		if om1[i] != om2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestOccMapSort(t *testing.T) {
+
+	om := WordsByOccurrence("b a c a d b a", false)
+	sort.Sort(om)
+
+	want := OccMap{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}
+	if !occMapIdentical(om, want) {
+		t.Errorf("sort.Sort(om) gave %v, wanted %v.", om, want)
+	}
+}
+
+func TestOccMapSortByCount(t *testing.T) {
+
+	cases := []struct {
+		o    Order
+		want OccMap
+	}{
+		{Descending, OccMap{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}},
+		{Ascending, OccMap{{"c", 1}, {"d", 1}, {"b", 2}, {"a", 3}}},
+	}
+
+	for _, c := range cases {
+		om := WordsByOccurrence("d b a c a b a", false)
+		if om.SortByCount(c.o); !occMapIdentical(om, c.want) {
+			t.Errorf("SortByCount(%d) gave %v, wanted %v.", c.o, om, c.want)
+		}
+	}
+}
+
+func TestOccMapSortAlpha(t *testing.T) {
+
+	cases := []struct {
+		o    Order
+		want OccMap
+	}{
+		{Ascending, OccMap{{"B", 1}, {"a", 2}, {"b", 1}, {"世", 1}}},
+		{Descending, OccMap{{"世", 1}, {"b", 1}, {"a", 2}, {"B", 1}}},
+	}
+
+	for _, c := range cases {
+		om := WordsByOccurrence("世 b a B a", false)
+		if om.SortAlpha(c.o); !occMapIdentical(om, c.want) {
+			t.Errorf("SortAlpha(%d) gave %v, wanted %v.", c.o, om, c.want)
+		}
+	}
+}
+
+func TestOccMapSortBy(t *testing.T) {
+
+	om := WordsByOccurrence("ccc a bb aa b c a", false)
+	om.SortAlpha(Ascending)
+	om.SortBy(func(a, b Occurrences) bool {
+		return len(a.SubStr) < len(b.SubStr)
+	})
+
+	want := OccMap{{"a", 2}, {"b", 1}, {"c", 1}, {"aa", 1}, {"bb", 1}, {"ccc", 1}}
+	if !occMapIdentical(om, want) {
+		t.Errorf("SortBy gave %v, wanted %v.", om, want)
+	}
+}
+
+func TestOccMapTopK(t *testing.T) {
+
+	om := make(OccMap, 0, 200)
+	for i := 0; i < 200; i++ {
+		om = append(om, Occurrences{SubStr: string(rune('a'+i%26)) + string(rune('A'+i/26)), N: i % 17})
+	}
+	rand.New(rand.NewSource(1)).Shuffle(len(om), om.Swap)
+
+	sorted := append(OccMap(nil), om...)
+	sorted.SortByCount(Descending)
+
+	for _, k := range []int{0, 1, 5, 17, 200, 500} {
+		want := sorted[:min(k, len(sorted))]
+		if got := om.TopK(k); !occMapIdentical(got, want) {
+			t.Errorf("TopK(%d) gave %v, wanted %v.", k, got, want)
+		}
+	}
+}
@@ -0,0 +1,447 @@
+package str
+
+import "strings"
+
+/*
+Stemmer reduces words to their stems so that inflected forms such as
+"run", "runs" and "running" can be counted as one word. A stem is not
+necessarily a word itself; "happiness" stems to "happi".
+
+EnglishStemmer is provided. Stemmers for other languages, such as
+those of the Snowball project, can be used by implementing Stem or by
+wrapping a function in StemmerFunc.
+*/
+type Stemmer interface {
+	Stem(word string) string
+}
+
+/*
+StemmerFunc adapts an ordinary function to the Stemmer interface.
+*/
+type StemmerFunc func(word string) string
+
+/*
+Stem returns f(word).
+*/
+func (f StemmerFunc) Stem(word string) string {
+	return f(word)
+}
+
+/*
+StemmedWordSet is the same as WordSet except that each word is
+replaced by its stem, so that words sharing a stem appear once. Stems
+are in order of their first appearance in s. If fold is true words
+are case folded before being stemmed.
+
+	ss := str.StemmedWordSet("Run, runner, running!", str.EnglishStemmer{}, true)
+	// ss is []string{"run", "runner"}
+*/
+func StemmedWordSet(s string, st Stemmer, fold bool) []string {
+	return defaultTokenizer.StemmedWordSet(s, st, fold)
+}
+
+/*
+StemmedWordsByOccurrence is the same as WordsByOccurrence except that
+words are counted by their stems. It also returns a map from each
+stem to the surface form that occurs most often in s, preferring the
+form that appears first in the event of a tie, which is useful for
+presenting stems to people. If fold is true words are case folded
+before being stemmed and counted.
+
+	om, forms := str.StemmedWordsByOccurrence("runs, running, runs", str.EnglishStemmer{}, false)
+	// om holds {"run", 3} and forms["run"] is "runs"
+*/
+func StemmedWordsByOccurrence(s string, st Stemmer, fold bool) (OccMap, map[string]string) {
+	return defaultTokenizer.StemmedWordsByOccurrence(s, st, fold)
+}
+
+/*
+StemmedWordSet is the same as the package level StemmedWordSet but
+uses t to find words.
+*/
+func (t *Tokenizer) StemmedWordSet(s string, st Stemmer, fold bool) []string {
+	_, stems := t.stemWords(s, st, fold)
+	return makeSet(stems, false)
+}
+
+/*
+StemmedWordsByOccurrence is the same as the package level
+StemmedWordsByOccurrence but uses t to find words.
+*/
+func (t *Tokenizer) StemmedWordsByOccurrence(s string, st Stemmer, fold bool) (OccMap, map[string]string) {
+
+	words, stems := t.stemWords(s, st, fold)
+
+	stemCounts := NewCounter(false)
+	wordCounts := NewCounter(false)
+	for i, w := range words {
+		stemCounts.Add(stems[i], 1)
+		wordCounts.Add(w, 1)
+	}
+
+	forms := make(map[string]string, stemCounts.Distinct())
+	for i, w := range words {
+		best, ok := forms[stems[i]]
+		if !ok || wordCounts.Get(w) > wordCounts.Get(best) {
+			forms[stems[i]] = w
+		}
+	}
+
+	return stemCounts.OccMap(), forms
+}
+
+/*
+stemWords returns the words in s, folded if required, alongside their
+stems. Words whose stem is empty, and stopwords, are omitted.
+*/
+func (t *Tokenizer) stemWords(s string, st Stemmer, fold bool) (words, stems []string) {
+	for _, w := range t.Words(s) {
+		if fold {
+			w = Fold(w)
+		}
+		if stem := st.Stem(w); stem != "" {
+			words = append(words, w)
+			stems = append(stems, stem)
+		}
+	}
+	return words, stems
+}
+
+/*
+EnglishStemmer implements the Porter2 stemming algorithm for English,
+also known as the Snowball English stemmer. Words are lowercased
+before being stemmed and curly apostrophes are treated as straight
+ones.
+
+	s := str.EnglishStemmer{}.Stem("running")      // "run"
+	s := str.EnglishStemmer{}.Stem("generously")   // "generous"
+	s := str.EnglishStemmer{}.Stem("consignments") // "consign"
+*/
+type EnglishStemmer struct{}
+
+/*
+porter2Exceptions holds words that Porter2 stems irregularly, or
+leaves unchanged, before any other rules are applied.
+*/
+var porter2Exceptions = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie",
+	"tying": "tie", "idly": "idl", "gently": "gentl", "ugly": "ugli",
+	"early": "earli", "only": "onli", "singly": "singl", "sky": "sky",
+	"news": "news", "howe": "howe", "atlas": "atlas", "cosmos": "cosmos",
+	"bias": "bias", "andes": "andes",
+}
+
+/*
+porter2Invariant holds words that are left as they are once step 1a
+has removed plurals.
+*/
+var porter2Invariant = map[string]bool{
+	"inning": true, "outing": true, "canning": true, "herring": true,
+	"earring": true, "proceed": true, "exceed": true, "succeed": true,
+}
+
+/*
+porter2Suffix is a suffix and its replacement in the second and
+later steps of Porter2. Lists of them are ordered longest first since
+only the longest matching suffix is considered.
+*/
+type porter2Suffix struct {
+	suffix, replacement string
+}
+
+var porter2Step2 = []porter2Suffix{
+	{"ization", "ize"}, {"ational", "ate"}, {"fulness", "ful"},
+	{"ousness", "ous"}, {"iveness", "ive"},
+	{"tional", "tion"}, {"biliti", "ble"}, {"lessli", "less"},
+	{"entli", "ent"}, {"ation", "ate"}, {"alism", "al"},
+	{"aliti", "al"}, {"ousli", "ous"}, {"iviti", "ive"}, {"fulli", "ful"},
+	{"enci", "ence"}, {"anci", "ance"}, {"abli", "able"},
+	{"izer", "ize"}, {"ator", "ate"}, {"alli", "al"},
+	{"bli", "ble"}, {"ogi", "og"},
+	{"li", ""},
+}
+
+var porter2Step3 = []porter2Suffix{
+	{"ational", "ate"},
+	{"tional", "tion"},
+	{"alize", "al"}, {"icate", "ic"}, {"iciti", "ic"}, {"ative", ""},
+	{"ical", "ic"}, {"ness", ""},
+	{"ful", ""},
+}
+
+var porter2Step4 = []string{
+	"ement",
+	"ance", "ence", "able", "ible", "ment",
+	"ant", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+	"al", "er", "ic",
+}
+
+/*
+Stem returns the Porter2 stem of word.
+*/
+func (EnglishStemmer) Stem(word string) string {
+
+	w := strings.ReplaceAll(strings.ToLower(word), "’", "'")
+	if len(w) <= 2 {
+		return w
+	}
+	if stem, ok := porter2Exceptions[w]; ok {
+		return stem
+	}
+
+	p := &porter2{b: []byte(strings.TrimPrefix(w, "'"))}
+
+	// Mark consonant Ys so they aren't treated as vowels.
+	for i, c := range p.b {
+		if c == 'y' && (i == 0 || isPorter2Vowel(p.b[i-1])) {
+			p.b[i] = 'Y'
+		}
+	}
+
+	p.regions()
+	p.step0()
+	p.step1a()
+
+	if !porter2Invariant[string(p.b)] {
+		p.step1b()
+		p.step1c()
+		p.step2()
+		p.step3()
+		p.step4()
+		p.step5()
+	}
+
+	return strings.ToLower(string(p.b))
+}
+
+/*
+porter2 holds a word as it is being stemmed and the start of its
+regions R1 and R2, which are fixed before any suffixes are removed.
+*/
+type porter2 struct {
+	b      []byte
+	r1, r2 int
+}
+
+func isPorter2Vowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+func (p *porter2) regions() {
+
+	// Find the region after the first non-vowel that follows a
+	// vowel at or after start.
+	region := func(start int) int {
+		for i := start + 1; i < len(p.b); i++ {
+			if isPorter2Vowel(p.b[i-1]) && !isPorter2Vowel(p.b[i]) {
+				return i + 1
+			}
+		}
+		return len(p.b)
+	}
+
+	p.r1 = -1
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(string(p.b), prefix) {
+			p.r1 = len(prefix)
+		}
+	}
+	if p.r1 < 0 {
+		p.r1 = region(0)
+	}
+	p.r2 = region(p.r1)
+}
+
+func (p *porter2) hasSuffix(suffix string) bool {
+	return strings.HasSuffix(string(p.b), suffix)
+}
+
+// replace replaces the last n bytes of p with s.
+func (p *porter2) replace(n int, s string) {
+	p.b = append(p.b[:len(p.b)-n], s...)
+}
+
+func (p *porter2) hasVowel(b []byte) bool {
+	for _, c := range b {
+		if isPorter2Vowel(c) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+endsShortSyllable reports whether b ends with a short syllable: a
+non-vowel, a vowel and a non-vowel other than w, x or Y, or a vowel
+and non-vowel at the start of the word.
+*/
+func (p *porter2) endsShortSyllable(b []byte) bool {
+	n := len(b)
+	switch {
+	case n == 2:
+		return isPorter2Vowel(b[0]) && !isPorter2Vowel(b[1])
+	case n > 2:
+		c := b[n-1]
+		return !isPorter2Vowel(b[n-3]) && isPorter2Vowel(b[n-2]) &&
+			!isPorter2Vowel(c) && c != 'w' && c != 'x' && c != 'Y'
+	}
+	return false
+}
+
+// isShort reports whether the word is a short word.
+func (p *porter2) isShort() bool {
+	return p.r1 >= len(p.b) && p.endsShortSyllable(p.b)
+}
+
+// step0 removes apostrophes marking possession.
+func (p *porter2) step0() {
+	for _, suffix := range []string{"'s'", "'s", "'"} {
+		if p.hasSuffix(suffix) {
+			p.replace(len(suffix), "")
+			return
+		}
+	}
+}
+
+// step1a removes plurals.
+func (p *porter2) step1a() {
+	n := len(p.b)
+	switch {
+	case p.hasSuffix("sses"):
+		p.replace(4, "ss")
+	case p.hasSuffix("ied"), p.hasSuffix("ies"):
+		if n > 4 {
+			p.replace(3, "i")
+		} else {
+			p.replace(3, "ie")
+		}
+	case p.hasSuffix("us"), p.hasSuffix("ss"):
+	case p.hasSuffix("s"):
+		if p.hasVowel(p.b[:n-2]) {
+			p.replace(1, "")
+		}
+	}
+}
+
+// step1b removes past participles and gerunds.
+func (p *porter2) step1b() {
+
+	var suffix string
+	for _, s := range []string{"eedly", "ingly", "edly", "eed", "ing", "ed"} {
+		if p.hasSuffix(s) {
+			suffix = s
+			break
+		}
+	}
+	start := len(p.b) - len(suffix)
+
+	switch suffix {
+	case "":
+		return
+	case "eed", "eedly":
+		if start >= p.r1 {
+			p.replace(len(suffix), "ee")
+		}
+		return
+	}
+
+	if !p.hasVowel(p.b[:start]) {
+		return
+	}
+	p.replace(len(suffix), "")
+
+	n := len(p.b)
+	switch {
+	case p.hasSuffix("at"), p.hasSuffix("bl"), p.hasSuffix("iz"):
+		p.replace(0, "e")
+	case n > 1 && p.b[n-1] == p.b[n-2] && strings.IndexByte("bdfgmnprt", p.b[n-1]) >= 0:
+		p.replace(1, "")
+	case p.isShort():
+		p.replace(0, "e")
+	}
+}
+
+// step1c replaces a final y after a consonant with i.
+func (p *porter2) step1c() {
+	n := len(p.b)
+	if n > 2 && (p.b[n-1] == 'y' || p.b[n-1] == 'Y') && !isPorter2Vowel(p.b[n-2]) {
+		p.b[n-1] = 'i'
+	}
+}
+
+func (p *porter2) step2() {
+	for _, s := range porter2Step2 {
+		if !p.hasSuffix(s.suffix) {
+			continue
+		}
+		start := len(p.b) - len(s.suffix)
+		if start < p.r1 {
+			return
+		}
+		switch s.suffix {
+		case "ogi":
+			if start == 0 || p.b[start-1] != 'l' {
+				return
+			}
+		case "li":
+			if start == 0 || strings.IndexByte("cdeghkmnrt", p.b[start-1]) < 0 {
+				return
+			}
+		}
+		p.replace(len(s.suffix), s.replacement)
+		return
+	}
+}
+
+func (p *porter2) step3() {
+	for _, s := range porter2Step3 {
+		if !p.hasSuffix(s.suffix) {
+			continue
+		}
+		start := len(p.b) - len(s.suffix)
+		if start < p.r1 || s.suffix == "ative" && start < p.r2 {
+			return
+		}
+		p.replace(len(s.suffix), s.replacement)
+		return
+	}
+}
+
+func (p *porter2) step4() {
+	for _, suffix := range porter2Step4 {
+		if !p.hasSuffix(suffix) {
+			continue
+		}
+		start := len(p.b) - len(suffix)
+		if start < p.r2 {
+			return
+		}
+		if suffix == "ion" && (start == 0 || p.b[start-1] != 's' && p.b[start-1] != 't') {
+			return
+		}
+		p.replace(len(suffix), "")
+		return
+	}
+}
+
+func (p *porter2) step5() {
+	n := len(p.b)
+	if n == 0 {
+		return
+	}
+	start := n - 1
+	switch p.b[start] {
+	case 'e':
+		if start >= p.r2 || start >= p.r1 && !p.endsShortSyllable(p.b[:start]) {
+			p.replace(1, "")
+		}
+	case 'l':
+		if start >= p.r2 && start > 0 && p.b[start-1] == 'l' {
+			p.replace(1, "")
+		}
+	}
+}
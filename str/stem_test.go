@@ -0,0 +1,121 @@
+package str
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnglishStemmer(t *testing.T) {
+
+	cases := []struct {
+		word string
+		want string
+	}{
+		{"running", "run"},
+		{"runs", "run"},
+		{"Running", "run"},
+		{"caresses", "caress"},
+		{"ponies", "poni"},
+		{"ties", "tie"},
+		{"cries", "cri"},
+		{"gaps", "gap"},
+		{"gas", "gas"},
+		{"kiwis", "kiwi"},
+		{"agreed", "agre"},
+		{"hopping", "hop"},
+		{"hoping", "hope"},
+		{"happily", "happili"},
+		{"generously", "generous"},
+		{"consignment", "consign"},
+		{"consistently", "consist"},
+		{"consolation", "consol"},
+		{"conspiracy", "conspiraci"},
+		{"knightly", "knight"},
+		{"knives", "knive"},
+		{"skies", "sky"},
+		{"dying", "die"},
+		{"succeeding", "succeed"},
+		{"herrings", "herring"},
+		{"cat's", "cat"},
+		{"cats’", "cat"},
+		{"by", "by"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := (EnglishStemmer{}).Stem(c.word); got != c.want {
+			t.Errorf("EnglishStemmer.Stem(%q) return %q, wanted %q.", c.word, got, c.want)
+		}
+	}
+}
+
+func TestStemmedWordSet(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		fold bool
+		want []string
+	}{
+		{"Run, runner, running!", true, []string{"run", "runner"}},
+		{"The cats sat with a cat.", false, []string{"the", "cat", "sat", "with", "a"}},
+		{"", false, []string{}},
+	}
+
+	for _, c := range cases {
+		got := StemmedWordSet(c.s, EnglishStemmer{}, c.fold)
+		if !strSliceEqual(got, c.want) {
+			t.Errorf("StemmedWordSet(%q, EnglishStemmer{}, %v) return %s, wanted %s.", c.s, c.fold, quoteSlice(got), quoteSlice(c.want))
+		}
+	}
+}
+
+func TestStemmedWordsByOccurrence(t *testing.T) {
+
+	s := "Runs, running, runs. The runner ran and ran."
+	om, forms := StemmedWordsByOccurrence(s, EnglishStemmer{}, true)
+
+	want := OccMap{
+		{"run", 3},
+		{"the", 1},
+		{"runner", 1},
+		{"ran", 2},
+		{"and", 1},
+	}
+	if !occMapsEqual(om, want) {
+		t.Errorf("StemmedWordsByOccurrence(%q, EnglishStemmer{}, true) return %v, wanted %v.", s, om, want)
+	}
+
+	wantForms := map[string]string{
+		"run":    "runs",
+		"the":    "the",
+		"runner": "runner",
+		"ran":    "ran",
+		"and":    "and",
+	}
+	if len(forms) != len(wantForms) {
+		t.Errorf("StemmedWordsByOccurrence(%q, EnglishStemmer{}, true) return %d forms, wanted %d.", s, len(forms), len(wantForms))
+	}
+	for stem, form := range wantForms {
+		if forms[stem] != form {
+			t.Errorf("StemmedWordsByOccurrence(%q, EnglishStemmer{}, true) form of %q is %q, wanted %q.", s, stem, forms[stem], form)
+		}
+	}
+
+	// Without folding the first of equally frequent forms wins.
+	s = "Running running"
+	_, forms = StemmedWordsByOccurrence(s, EnglishStemmer{}, false)
+	if forms["run"] != "Running" {
+		t.Errorf("StemmedWordsByOccurrence(%q, EnglishStemmer{}, false) form of %q is %q, wanted %q.", s, "run", forms["run"], "Running")
+	}
+}
+
+func TestStemmerFunc(t *testing.T) {
+
+	upper := StemmerFunc(strings.ToUpper)
+	got := StemmedWordSet("one two one", upper, false)
+	want := []string{"ONE", "TWO"}
+
+	if !strSliceEqual(got, want) {
+		t.Errorf("StemmedWordSet with StemmerFunc return %s, wanted %s.", quoteSlice(got), quoteSlice(want))
+	}
+}
@@ -0,0 +1,96 @@
+package str
+
+/*
+stopwordLists holds the built-in stopword lists keyed by ISO 639-1
+language code. Words are lowercase and separated by spaces.
+*/
+var stopwordLists = map[string]string{
+
+	// English
+	"en": `i me my myself we our ours ourselves you your yours yourself
+	yourselves he him his himself she her hers herself it its itself they
+	them their theirs themselves what which who whom this that these those
+	am is are was were be been being have has had having do does did doing
+	a an the and but if or because as until while of at by for with about
+	against between into through during before after above below to from
+	up down in out on off over under again further then once here there
+	when where why how all any both each few more most other some such no
+	nor not only own same so than too very can will just should now
+	would could i'm i've i'd i'll you're you've you'd you'll he's she's
+	it's we're we've we'd we'll they're they've they'd they'll that's
+	there's let's don't doesn't didn't isn't aren't wasn't weren't hasn't
+	haven't hadn't won't wouldn't can't couldn't shouldn't mustn't
+	mightn't needn't shan't`,
+
+	// French
+	"fr": `au aux avec ce ces cet cette dans de des du elle elles en et eux
+	il ils je la le les leur leurs lui ma mais me même mes moi mon ne nos
+	notre nous on ou où par pas pour qu que qui sa se ses son sur ta te
+	tes toi ton tu un une vos votre vous à y été être suis es est sommes
+	êtes sont étais était étions étiez étaient ai as avons avez ont avais
+	avait avions aviez avaient avoir fait comme plus si tout tous toute
+	toutes très sans sous aussi bien car donc alors ceci cela ça`,
+
+	// German
+	"de": `aber alle allem allen aller alles als also am an andere anderem
+	anderen anderer anderes anders auch auf aus bei bin bis bist da damit
+	dann das dass daß dein deine deinem deinen deiner deines dem den denn
+	der derer des dessen dich die dies diese diesem diesen dieser dieses
+	dir doch dort du durch ein eine einem einen einer eines einige einigem
+	einigen einiger einiges einmal er es etwas euch euer eure eurem euren
+	eurer eures für gegen gewesen habe haben hat hatte hatten hier hin
+	hinter ich ihm ihn ihnen ihr ihre ihrem ihren ihrer ihres im in indem
+	ins ist jede jedem jeden jeder jedes jene jenem jenen jener jenes jetzt
+	kann kein keine keinem keinen keiner keines können könnte man manche
+	manchem manchen mancher manches mein meine meinem meinen meiner meines
+	mich mir mit muss musste nach nicht nichts noch nun nur ob oder ohne
+	sehr sein seine seinem seinen seiner seines selbst sich sie sind so
+	solche solchem solchen solcher solches soll sollte sondern sonst über
+	um und uns unser unsere unserem unseren unserer unseres unter viel vom
+	von vor war waren warst was weil weiter welche welchem welchen welcher
+	welches wenn werde werden wie wieder will wir wird wirst wo wollen
+	wollte während würde würden zu zum zur zwar zwischen`,
+
+	// Spanish
+	"es": `de la que el en y a los del se las por un para con no una su al
+	lo como más pero sus le ya o este sí porque esta entre cuando muy sin
+	sobre también me hasta hay donde quien desde todo nos durante todos
+	uno les ni contra otros ese eso ante ellos e esto mí antes algunos qué
+	unos yo otro otras otra él tanto esa estos mucho quienes nada muchos
+	cual poco ella estar estas algunas algo nosotros nosotras vosotros
+	vosotras mi mis tú te ti tu tus ellas os mío mía míos mías tuyo tuya
+	suyo suya nuestro nuestra nuestros nuestras vuestro vuestra esos esas
+	estoy estás está estamos estáis están es son soy eres somos era eran
+	fue fueron ha han he has hemos había ser`,
+
+	// Italian
+	"it": `ad al allo ai agli alla alle con col coi da dal dallo dai dagli
+	dalla dalle di del dello dei degli della delle in nel nello nei negli
+	nella nelle su sul sullo sui sugli sulla sulle per tra contro io tu
+	lui lei noi voi loro mio mia miei mie tuo tua tuoi tue suo sua suoi
+	sue nostro nostra nostri nostre vostro vostra vostri vostre mi ti ci
+	vi lo la li le gli ne il un uno una ma ed se perché anche come dove
+	che chi cui non più quale quanto quanti quanta quante quello quelli
+	quella quelle questo questi questa queste si tutto tutti a e i o ho
+	hai ha abbiamo avete hanno è sono sei siamo siete era erano fu essere
+	avere`,
+
+	// Portuguese
+	"pt": `de a o que e do da em um para com não uma os no se na por mais
+	as dos como mas ao ele das à seu sua ou quando muito nos já eu também
+	só pelo pela até isso ela entre depois sem mesmo aos seus quem nas me
+	esse eles você essa num nem suas meu às minha numa pelos elas qual nós
+	lhe deles essas esses pelas este dele tu te vocês vos lhes meus minhas
+	teu tua teus tuas nosso nossa nossos nossas dela delas esta estes
+	estas aquele aquela aqueles aquelas isto aquilo estou está estamos
+	estão sou é somos são era eram foi foram tem têm ter há ser`,
+
+	// Dutch
+	"nl": `de en van ik te dat die in een hij het niet zijn is was op aan
+	met als voor had er maar om hem dan zou of wat mijn men dit zo door
+	over ze zich bij ook tot je mij uit der daar haar naar heb hoe heeft
+	hebben deze u want nog zal me zij nu ge geen omdat iets worden toch al
+	waren veel meer doen toen moet ben zonder kan hun dus alles onder ja
+	eens hier wie werd altijd doch wordt wezen kunnen ons zelf tegen na
+	reeds wil kon niets uw iemand geweest andere`,
+}
@@ -0,0 +1,166 @@
+package str
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+/*
+StopwordSet holds words, such as "the", "a" and "of", that are too
+common to be of interest in word frequency reports. Setting a
+Tokenizer's Stopwords field omits them from its results.
+
+Words are matched ignoring case, as defined by Fold, and ignoring
+the difference between straight and curly apostrophes, so "The",
+"the" and "THE" are all matched by "the", and "don’t" by "don't".
+This is so wherever a set is used.
+
+The zero value is an empty set ready to use. A nil *StopwordSet
+contains no words.
+
+	stop, err := str.Stopwords("en")
+	if err != nil {
+		// Handle error.
+	}
+	stop.Add("said")
+	t := str.Tokenizer{
+		Grammar:    str.DefaultGrammar,
+		Boundaries: str.DefaultBoundaries,
+		Stopwords:  stop,
+	}
+	om := t.WordsByOccurrence(s, true)
+*/
+type StopwordSet struct {
+	// words holds the stopwords as returned by stopwordKey.
+	words Set[string]
+}
+
+/*
+NewStopwordSet returns a StopwordSet containing words.
+*/
+func NewStopwordSet(words ...string) *StopwordSet {
+	set := &StopwordSet{}
+	set.Add(words...)
+	return set
+}
+
+/*
+Stopwords returns a new StopwordSet containing the built-in stopwords
+for each of the languages given by their ISO 639-1 codes. Lists are
+available for English ("en"), French ("fr"), German ("de"), Spanish
+("es"), Italian ("it"), Portuguese ("pt") and Dutch ("nl"). An error
+is returned if any language is unknown.
+
+The set is a copy and may be freely modified.
+*/
+func Stopwords(langs ...string) (*StopwordSet, error) {
+	set := &StopwordSet{}
+	for _, lang := range langs {
+		list, ok := stopwordLists[strings.ToLower(lang)]
+		if !ok {
+			return nil, fmt.Errorf("no stopwords for language %q", lang)
+		}
+		set.Add(strings.Fields(list)...)
+	}
+	return set, nil
+}
+
+/*
+ReadStopwords returns a StopwordSet containing the words read from r.
+See AddFrom for the format of r.
+*/
+func ReadStopwords(r io.Reader) (*StopwordSet, error) {
+	set := &StopwordSet{}
+	if err := set.AddFrom(r); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+/*
+Add adds words to set.
+*/
+func (set *StopwordSet) Add(words ...string) {
+	for _, w := range words {
+		set.words.Add(stopwordKey(w))
+	}
+}
+
+/*
+AddFrom adds the words read from r to set. Words are separated by
+spaces or line breaks. Anything following a '#' or '|' on a line is
+a comment and ignored, which allows files in the format used by the
+Snowball project to be read directly.
+*/
+func (set *StopwordSet) AddFrom(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexAny(line, "#|"); i >= 0 {
+			line = line[:i]
+		}
+		set.Add(strings.Fields(line)...)
+	}
+	return sc.Err()
+}
+
+/*
+Remove removes words from set.
+*/
+func (set *StopwordSet) Remove(words ...string) {
+	for _, w := range words {
+		set.words.Remove(stopwordKey(w))
+	}
+}
+
+/*
+Contains reports whether word is in set.
+*/
+func (set *StopwordSet) Contains(word string) bool {
+	return set != nil && set.words.Contains(stopwordKey(word))
+}
+
+/*
+Len returns the number of words in set.
+*/
+func (set *StopwordSet) Len() int {
+	if set == nil {
+		return 0
+	}
+	return set.words.Len()
+}
+
+/*
+Words returns the words in set in alphabetical order. They are case
+folded and their apostrophes are straight.
+*/
+func (set *StopwordSet) Words() []string {
+	if set == nil {
+		return []string{}
+	}
+	words := set.words.Items()
+	sort.Strings(words)
+	return words
+}
+
+/*
+Filter returns the words in ww that are not in set, in their original
+order.
+*/
+func (set *StopwordSet) Filter(ww []string) []string {
+	filtered := make([]string, 0, len(ww))
+	for _, w := range ww {
+		if !set.Contains(w) {
+			filtered = append(filtered, w)
+		}
+	}
+	return filtered
+}
+
+// stopwordKey returns w as it is held in a StopwordSet.
+func stopwordKey(w string) string {
+	return strings.ReplaceAll(Fold(w), "’", "'")
+}
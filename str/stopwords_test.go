@@ -0,0 +1,151 @@
+package str
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStopwords(t *testing.T) {
+
+	cases := []struct {
+		langs []string
+		word  string
+		want  bool
+	}{
+		{[]string{"en"}, "the", true},
+		{[]string{"en"}, "The", true},
+		{[]string{"en"}, "THE", true},
+		{[]string{"en"}, "don't", true},
+		{[]string{"en"}, "don’t", true},
+		{[]string{"en"}, "Don’t", true},
+		{[]string{"en"}, "cat", false},
+		{[]string{"fr"}, "les", true},
+		{[]string{"de"}, "über", true},
+		{[]string{"EN", "es"}, "porque", true},
+		{[]string{"en", "es"}, "of", true},
+		{[]string{}, "the", false},
+	}
+
+	for _, c := range cases {
+		set, err := Stopwords(c.langs...)
+		if err != nil {
+			t.Errorf("Stopwords(%q) return error %q, wanted nil.", c.langs, err)
+			continue
+		}
+		if got := set.Contains(c.word); got != c.want {
+			t.Errorf("Stopwords(%q).Contains(%q) return %v, wanted %v.", c.langs, c.word, got, c.want)
+		}
+	}
+
+	if _, err := Stopwords("en", "xx"); err == nil {
+		t.Errorf("Stopwords(%q, %q) return nil error, wanted non-nil.", "en", "xx")
+	}
+}
+
+func TestStopwordSet(t *testing.T) {
+
+	var set StopwordSet
+	if set.Contains("a") || set.Len() != 0 {
+		t.Errorf("zero StopwordSet is not empty.")
+	}
+
+	set.Add("a", "the", "a")
+	set.Remove("the", "missing")
+	if got := set.Words(); !strSliceEqual(got, []string{"a"}) {
+		t.Errorf("StopwordSet.Words() return %s, wanted %s.", quoteSlice(got), quoteSlice([]string{"a"}))
+	}
+
+	var nilSet *StopwordSet
+	if nilSet.Contains("a") || nilSet.Len() != 0 || len(nilSet.Words()) != 0 {
+		t.Errorf("nil StopwordSet is not empty.")
+	}
+}
+
+func TestReadStopwords(t *testing.T) {
+
+	input := "| Snowball style comment\nthe  a # trailing comment\n\nof | another\n"
+	set, err := ReadStopwords(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadStopwords(%q) return error %q, wanted nil.", input, err)
+	}
+
+	want := []string{"a", "of", "the"}
+	if got := set.Words(); !strSliceEqual(got, want) {
+		t.Errorf("ReadStopwords(%q) return %s, wanted %s.", input, quoteSlice(got), quoteSlice(want))
+	}
+}
+
+func TestStopwordSetFilter(t *testing.T) {
+
+	set := NewStopwordSet("the", "of", "Straße", "isn’t")
+
+	cases := []struct {
+		ww   []string
+		want []string
+	}{
+		{[]string{"The", "end", "of", "the", "road"}, []string{"end", "road"}},
+		{[]string{"STRASSE", "strasse", "Straße", "road"}, []string{"road"}},
+		{[]string{"isn't", "ISN’T", "is"}, []string{"is"}},
+		{[]string{}, []string{}},
+	}
+
+	for _, c := range cases {
+		if got := set.Filter(c.ww); !strSliceEqual(got, c.want) {
+			t.Errorf("StopwordSet.Filter(%s) return %s, wanted %s.", quoteSlice(c.ww), quoteSlice(got), quoteSlice(c.want))
+		}
+	}
+
+	// Words differing only in case are one stopword.
+	set.Remove("STRASSE")
+	if set.Contains("Straße") {
+		t.Errorf("StopwordSet.Contains(%q) return true after removing %q, wanted false.", "Straße", "STRASSE")
+	}
+	if got, want := set.Words(), []string{"isn't", "of", "the"}; !strSliceEqual(got, want) {
+		t.Errorf("StopwordSet.Words() return %s, wanted %s.", quoteSlice(got), quoteSlice(want))
+	}
+}
+
+func TestTokenizerStopwords(t *testing.T) {
+
+	tk := Tokenizer{
+		Grammar:    DefaultGrammar,
+		Boundaries: DefaultBoundaries,
+		Stopwords:  NewStopwordSet("the", "of", "a"),
+	}
+	s := "The end of the road, a cat."
+
+	words := []string{"end", "road", "cat"}
+	if got := tk.Words(s); !strSliceEqual(got, words) {
+		t.Errorf("Tokenizer.Words(%q) return %s, wanted %s.", s, quoteSlice(got), quoteSlice(words))
+	}
+	if got := tk.WordCount(s); got != len(words) {
+		t.Errorf("Tokenizer.WordCount(%q) return %d, wanted %d.", s, got, len(words))
+	}
+
+	var scanned []string
+	ws := tk.NewWordScanner(strings.NewReader(s))
+	for ws.Scan() {
+		scanned = append(scanned, ws.Text())
+	}
+	if !strSliceEqual(scanned, words) {
+		t.Errorf("Tokenizer.NewWordScanner(%q) yield %s, wanted %s.", s, quoteSlice(scanned), quoteSlice(words))
+	}
+
+	cases := []struct {
+		fold bool
+		want []string
+	}{
+		{false, []string{"end", "road", "cat"}},
+		{true, []string{"end", "road", "cat"}},
+	}
+
+	for _, c := range cases {
+		if got := tk.WordSet(s, c.fold); !strSliceEqual(got, c.want) {
+			t.Errorf("Tokenizer.WordSet(%q, %v) return %s, wanted %s.", s, c.fold, quoteSlice(got), quoteSlice(c.want))
+		}
+		om := tk.WordsByOccurrence(s, c.fold)
+		if len(om) != len(c.want) {
+			t.Errorf("Tokenizer.WordsByOccurrence(%q, %v) return %v, wanted %d entries.", s, c.fold, om, len(c.want))
+		}
+	}
+}
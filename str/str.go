@@ -5,6 +5,15 @@ contain single or multi-byte runes.
 
 Where appropriate it mirrors the behaviour of the standard
 library's strings package.
+
+Text segmentation, display width and normalization use tables
+derived from Unicode 15.0 alone, so they do not change with the
+version of Go. Case mapping and folding use the standard library's
+unicode package, whose version is given by unicode.Version, along
+with tables derived from Unicode 17.0. Characters added since
+Unicode 15.0 may therefore be cased and folded correctly yet be
+treated as unassigned when segmenting, measuring or normalizing
+text.
 */
 package str
 
@@ -14,9 +23,10 @@ import (
 )
 
 /*
-In returns true if s is in ss.
+In returns true if s is in ss. It scans ss each time it is called; to
+test many values against the same slice use a Set.
 */
-func In(ss []string, s string) bool {
+func In[T comparable](ss []T, s T) bool {
 	for i := range ss {
 		if ss[i] == s {
 			return true
@@ -25,6 +35,32 @@ func In(ss []string, s string) bool {
 	return false
 }
 
+/*
+InFunc returns true if f returns true for any element of ss.
+*/
+func InFunc[T any](ss []T, f func(T) bool) bool {
+	for i := range ss {
+		if f(ss[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+InFold returns true if s is in ss, ignoring differences in case as
+defined by full Unicode case folding. See Fold.
+
+	ok := str.InFold([]string{"Straße", "ΣΊΣΥΦΟΣ"}, "σίσυφος") // true
+	ok := str.InFold([]string{"Straße"}, "STRASSE")            // true
+*/
+func InFold(ss []string, s string) bool {
+	s = Fold(s)
+	return InFunc(ss, func(e string) bool {
+		return Fold(e) == s
+	})
+}
+
 /*
 Len returns the number of runes in a string rather
 than the number of bytes.
@@ -308,9 +344,10 @@ non-nil and zero length. Characters appear in the same order as they
 do in s.
 
 If fold is set to true runes of different cases will be considered
-duplicates and condensed into a single lowercase character in the
-resulting slice. For example, if s were "Hi, Hello" the output with
-fold set to true would be []string{"h", "i", ",", " ", "e", "l", "o"}
+duplicates and condensed into a single case folded character in the
+resulting slice (see Fold). For example, if s were "Hi, Hello" the
+output with fold set to true would be
+[]string{"h", "i", ",", " ", "e", "l", "o"}
 */
 func CharSet(s string, fold bool) []string {
 	return makeSet(strings.Split(s, ""), fold)
@@ -322,7 +359,8 @@ represents a single rune and the number of times it appears in s.
 OccMap implements sort.Interface; see OccMap for more details.
 
 If fold is set to true runes of different cases will be considered
-equal and all entries in the resulting slice will be in lowercase.
+equal and all entries in the resulting slice will be case folded, as
+by Fold.
 For example, "h" and "H" will each count towards an occurrence of the
 single character, "h".
 */
@@ -337,7 +375,7 @@ func makeSet(ss []string, fold bool) []string {
 
 	for _, s := range ss {
 		if fold {
-			s = strings.ToLower(s)
+			s = Fold(s)
 		}
 		if seen[s] {
 			continue
@@ -393,15 +431,14 @@ func abs(n int) int {
 }
 
 /*
-Capitalise returns a copy of s with its first rune
-converted to upper case if possible.
+Capitalise returns a copy of s with its first rune converted to
+title case if possible. Title case differs from upper case for a few
+digraphs, such as "ǆ", whose title case is "ǅ", and for ligatures,
+such as "ﬁ", which becomes "Fi". See Caser for language specific
+rules.
 */
 func Capitalise(s string) string {
-	rr := []rune(s)
-	if len(rr) < 2 {
-		return strings.ToUpper(s)
-	}
-	return strings.ToUpper(string(rr[0])) + string(rr[1:])
+	return Caser{}.Capitalise(s)
 }
 
 /*
@@ -459,6 +496,9 @@ omitted. Grammatical marks are defined as one of the following:
 Grammar within a word, such as apostrophes indicating contractions,
 are retained.
 
+Words uses the default rules described above. To change which
+characters count as grammar or word boundaries see Tokenizer.
+
 	ww := Words(`"Here's a sentence," said the narrator/programmer.`)
 	// ww is []string{
 	// 	"Here's",
@@ -472,36 +512,7 @@ are retained.
 
 */
 func Words(s string) []string {
-
-	cc := strings.Split(s, "")
-
-	// Approximate how long our words slice will need to be
-	// to avoid repeated expansions.
-	avgWordLen := 5.5
-	words := make([]string, 0, int(float64(len(cc))/avgWordLen))
-
-	precededByBoundary := true
-	idx := -1
-
-	for i, c := range cc {
-		if grammarOnBoundary(cc, i, precededByBoundary) {
-			continue
-		}
-		if isBoundaryChar(c) {
-			precededByBoundary = true
-			continue
-		}
-		if precededByBoundary {
-			words = append(words, c)
-			precededByBoundary = false
-			idx++
-			continue
-		}
-		words[idx] += c
-		precededByBoundary = false
-	}
-
-	return words
+	return defaultTokenizer.Words(s)
 }
 
 /*
@@ -510,65 +521,7 @@ WordCount returns the number of words in s.
 See Words for what a word is in this context.
 */
 func WordCount(s string) int {
-
-	cc := strings.Split(s, "")
-	precededByBoundary := true
-	var count int
-
-	for _, c := range cc {
-		if isGrammar(c) {
-			continue
-		}
-		if isBoundaryChar(c) {
-			precededByBoundary = true
-			continue
-		}
-		if precededByBoundary {
-			count++
-			precededByBoundary = false
-		}
-	}
-
-	return count
-}
-
-func grammarOnBoundary(cc []string, i int, precededByBoundary bool) bool {
-	for {
-		if !isGrammar(cc[i]) {
-			return false
-		}
-		if precededByBoundary {
-			return true
-		}
-		if boundaryNext(cc, i) {
-			return true
-		}
-		i++
-	}
-}
-
-func isGrammar(c string) bool {
-	grammar := `!?,.'"[]()*~{}:;-<>+=|%&@#$^\` + "`"
-	return strings.Contains(grammar, c)
-}
-
-func isBoundaryChar(c string) bool {
-	splitters := "–—/" // endash, emdash, and forward slash
-	if strings.Contains(splitters, c) {
-		return true
-	}
-	if strings.TrimSpace(c) == "" {
-		return true
-	}
-	return false
-}
-
-func boundaryNext(cc []string, i int) bool {
-	i++
-	if i == len(cc) {
-		return true
-	}
-	return isBoundaryChar(cc[i])
+	return defaultTokenizer.WordCount(s)
 }
 
 /*
@@ -576,14 +529,15 @@ WordSet is the same as Words but removes duplicates from its results.
 Words will appear in order of their first appearance in s.
 
 If fold is set to true words of different cases will be considered
-duplicates and condensed into a single lowercase word in the resulting
-slice. For example, if s were "hello, Hello, hELlo there!" the output
-with fold set to true would be []string{"hello", "there"}
+duplicates and condensed into a single case folded word in the
+resulting slice (see Fold). For example, if s were
+"hello, Hello, hELlo there!" the output with fold set to true would
+be []string{"hello", "there"}
 
 See Words for what a word is in this context.
 */
 func WordSet(s string, fold bool) []string {
-	return makeSet(Words(s), fold)
+	return defaultTokenizer.WordSet(s, fold)
 }
 
 /*
@@ -598,8 +552,11 @@ type Occurrences struct {
 /*
 OccMap is an unordered sequence of Occurrences. It implements
 sort.Interface and will sort the substrings from most frequent to
-least, though no order is guaranteed among substrings with the
-same number of occurrences.
+least. Substrings with the same number of occurrences are sorted
+in ascending byte-wise order so that the result is deterministic.
+
+See SortByCount, SortAlpha and SortBy for other orderings and TopK
+for finding the most frequent substrings without sorting.
 */
 type OccMap []Occurrences
 
@@ -608,7 +565,10 @@ func (om OccMap) Len() int {
 }
 
 func (om OccMap) Less(i, j int) bool {
-	return om[i].N > om[j].N
+	if om[i].N != om[j].N {
+		return om[i].N > om[j].N
+	}
+	return om[i].SubStr < om[j].SubStr
 }
 
 func (om OccMap) Swap(i, j int) {
@@ -621,30 +581,19 @@ represents a single word and the number of times it appears in s.
 OccMap implements sort.Interface; see OccMap for more details.
 
 If fold is set to true words of different cases will be considered
-equal and all entries in the resulting slice will be in lowercase.
+equal and all entries in the resulting slice will be case folded, as
+by Fold.
 For example, "hello" and "Hello" will each count towards an occurrence
 of the single word, "hello".
 
 See Words for what a word is in this context.
 */
 func WordsByOccurrence(s string, fold bool) OccMap {
-	return occurrences(Words(s), fold)
+	return defaultTokenizer.WordsByOccurrence(s, fold)
 }
 
 func occurrences(ss []string, fold bool) OccMap {
-
-	occs := make(map[string]int, len(ss))
-	for _, s := range ss {
-		if fold {
-			s = strings.ToLower(s)
-		}
-		occs[s]++
-	}
-
-	om := make(OccMap, 0, len(occs))
-	for s, o := range occs {
-		om = append(om, Occurrences{SubStr: s, N: o})
-	}
-
-	return om
+	c := NewCounter(fold)
+	c.AddAll(ss)
+	return c.OccMap()
 }
@@ -27,6 +27,60 @@ func TestIn(t *testing.T) {
 			t.Errorf("In(%v, %q) return %v, wanted %v.", c.ss, c.s, got, c.want)
 		}
 	}
+
+	if !In([]rune("世界"), '界') {
+		t.Errorf("In(%q, %q) return false, wanted true.", []rune("世界"), '界')
+	}
+	if In([]int{1, 2, 3}, 4) {
+		t.Errorf("In(%v, %d) return true, wanted false.", []int{1, 2, 3}, 4)
+	}
+}
+
+func TestInFunc(t *testing.T) {
+
+	ss := []string{"hi", "there", "yoo"}
+
+	cases := []struct {
+		n    int
+		want bool
+	}{
+		{2, true},
+		{5, true},
+		{4, false},
+	}
+
+	for _, c := range cases {
+		got := InFunc(ss, func(s string) bool {
+			return len(s) == c.n
+		})
+		if got != c.want {
+			t.Errorf("InFunc(%v, len == %d) return %v, wanted %v.", ss, c.n, got, c.want)
+		}
+	}
+}
+
+func TestInFold(t *testing.T) {
+
+	cases := []struct {
+		ss   []string
+		s    string
+		want bool
+	}{
+		{[]string{"hi", "There", "yoo"}, "tHERE", true},
+		{[]string{"hi", "There", "yoo"}, "here", false},
+		{[]string{"ΣΊΣΥΦΟΣ"}, "σίσυφος", true},
+		{[]string{"Straße"}, "strasse", true},
+		{[]string{"STRASSE"}, "straße", true},
+		{[]string{"kelvin"}, "\u212aelvin", true}, // Kelvin sign
+		{[]string{"世界"}, "世界", true},
+		{nil, "", false},
+	}
+
+	for _, c := range cases {
+		if got := InFold(c.ss, c.s); got != c.want {
+			t.Errorf("InFold(%q, %q) return %v, wanted %v.", c.ss, c.s, got, c.want)
+		}
+	}
 }
 
 func TestSplitBefore(t *testing.T) {
@@ -0,0 +1,401 @@
+package str
+
+import (
+	"io"
+	"strings"
+)
+
+/*
+Align is the alignment of the cells in a table column.
+*/
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+
+	// AlignDecimal lines numbers up on their decimal points.
+	// Cells without a decimal point are aligned as though one
+	// followed them. Headers are aligned right.
+	AlignDecimal
+)
+
+/*
+Border is the style of the lines drawn around and within a table.
+*/
+type Border int
+
+const (
+	// BorderNone separates columns with two spaces and draws
+	// no lines.
+	BorderNone Border = iota
+
+	// BorderASCII draws lines with '+', '-' and '|'.
+	BorderASCII
+
+	// BorderBox draws lines with box drawing characters.
+	BorderBox
+
+	// BorderMarkdown renders the table as a GitHub Flavored
+	// Markdown table. Pipes in cells are escaped and line
+	// breaks within cells are written as "<br>".
+	BorderMarkdown
+)
+
+/*
+Column describes a table column. The zero value is a left aligned
+column without a header that may be any width.
+*/
+type Column struct {
+	Header string
+	Align  Align
+
+	// MaxWidth, if greater than zero, is the maximum width of
+	// the column's cells measured in the table's Unit. Longer
+	// lines are truncated with an ellipsis, as by Truncate,
+	// unless Wrap is true.
+	MaxWidth int
+
+	// Wrap wraps lines longer than MaxWidth rather than
+	// truncating them. See Wrap.
+	Wrap bool
+}
+
+/*
+Table lays out rows of text in aligned columns. Cells may contain
+line breaks, in which case the row is as tall as its tallest cell.
+The headers are only shown if at least one column has one.
+
+The zero value is an empty table without borders ready to use.
+Columns may be added to or changed at any time before it is
+rendered; rows with more cells than there are Columns are given
+extra columns with default settings.
+
+	t := str.NewTable("Fruit", "Price")
+	t.Columns[1].Align = str.AlignDecimal
+	t.Border = str.BorderBox
+	t.AddRow("Apple", "1.5")
+	t.AddRow("Watermelon", "12.25")
+	fmt.Print(t)
+	// ┌────────────┬───────┐
+	// │ Fruit      │ Price │
+	// ├────────────┼───────┤
+	// │ Apple      │  1.5  │
+	// │ Watermelon │ 12.25 │
+	// └────────────┴───────┘
+*/
+type Table struct {
+	Columns []Column
+	Border  Border
+
+	// Unit is the unit in which cells are measured. Use
+	// ByWidth for tables printed to a terminal that contain
+	// wide characters.
+	Unit Unit
+
+	rows [][]string
+}
+
+/*
+NewTable returns a Table with a column for each of headers.
+*/
+func NewTable(headers ...string) *Table {
+	t := &Table{}
+	for _, h := range headers {
+		t.Columns = append(t.Columns, Column{Header: h})
+	}
+	return t
+}
+
+/*
+AddRow adds a row to t. Missing cells at the end of the row are left
+empty.
+*/
+func (t *Table) AddRow(cells ...string) {
+	t.rows = append(t.rows, append([]string(nil), cells...))
+}
+
+/*
+String returns t rendered as text. Each line, including the last, ends
+with a line break.
+*/
+func (t *Table) String() string {
+
+	ncols := len(t.Columns)
+	for _, row := range t.rows {
+		ncols = max(ncols, len(row))
+	}
+	if ncols == 0 {
+		return ""
+	}
+
+	cols := make([]Column, ncols)
+	copy(cols, t.Columns)
+
+	header := t.Border == BorderMarkdown
+	for _, c := range cols {
+		if c.Header != "" {
+			header = true
+		}
+	}
+
+	rows := t.rows
+	if header {
+		headers := make([]string, ncols)
+		for i, c := range cols {
+			headers[i] = c.Header
+		}
+		rows = append([][]string{headers}, rows...)
+	}
+
+	// grid holds the lines of each cell.
+	grid := make([][][]string, len(rows))
+	for r, row := range rows {
+		grid[r] = make([][]string, ncols)
+		for c := range cols {
+			var cell string
+			if c < len(row) {
+				cell = row[c]
+			}
+			grid[r][c] = t.cellLines(cell, cols[c])
+		}
+	}
+
+	body := 0
+	if header {
+		body = 1
+	}
+	for c, col := range cols {
+		if col.Align == AlignDecimal {
+			t.alignDecimal(grid[body:], c)
+		}
+	}
+
+	widths := make([]int, ncols)
+	for c := range cols {
+		if t.Border == BorderMarkdown {
+			widths[c] = 3
+		}
+		for r := range grid {
+			for _, line := range grid[r][c] {
+				widths[c] = max(widths[c], t.Unit.measure(line))
+			}
+		}
+	}
+
+	st := borderStyles[t.Border]
+	var b strings.Builder
+
+	t.writeRule(&b, st.top, widths)
+	for r := range grid {
+		t.writeRow(&b, st, grid[r], cols, widths)
+		if header && r == 0 {
+			if t.Border == BorderMarkdown {
+				t.writeMarkdownRule(&b, cols, widths)
+			} else {
+				t.writeRule(&b, st.mid, widths)
+			}
+		}
+	}
+	t.writeRule(&b, st.bottom, widths)
+
+	return b.String()
+}
+
+/*
+WriteTo writes t rendered as text to w. It implements io.WriterTo.
+*/
+func (t *Table) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, t.String())
+	return int64(n), err
+}
+
+/*
+cellLines returns the lines of cell with col's maximum width applied.
+*/
+func (t *Table) cellLines(cell string, col Column) []string {
+
+	var lines []string
+	for _, line := range strings.Split(cell, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		switch {
+		case col.MaxWidth <= 0:
+			lines = append(lines, line)
+		case col.Wrap:
+			lines = append(lines, WrapLines(line, col.MaxWidth, WrapOptions{
+				Unit:       t.Unit,
+				BreakWords: true,
+			})...)
+		default:
+			lines = append(lines, Truncate(line, col.MaxWidth, TruncateOptions{Unit: t.Unit}))
+		}
+	}
+
+	if t.Border == BorderMarkdown {
+		cell = strings.Join(lines, "<br>")
+		return []string{strings.ReplaceAll(cell, "|", `\|`)}
+	}
+	return lines
+}
+
+/*
+alignDecimal pads the lines of column c in rows so that their decimal
+points line up.
+*/
+func (t *Table) alignDecimal(rows [][][]string, c int) {
+
+	var intLen, fracLen int
+	for _, row := range rows {
+		for _, line := range row[c] {
+			i, f := splitDecimal(line)
+			intLen = max(intLen, t.Unit.measure(i))
+			fracLen = max(fracLen, t.Unit.measure(f))
+		}
+	}
+
+	for _, row := range rows {
+		for j, line := range row[c] {
+			i, f := splitDecimal(line)
+			lpad, _ := padUnits(intLen-t.Unit.measure(i), ' ', t.Unit)
+			rpad, _ := padUnits(fracLen-t.Unit.measure(f), ' ', t.Unit)
+			row[c][j] = lpad + line + rpad
+		}
+	}
+}
+
+// splitDecimal splits s before its decimal point.
+func splitDecimal(s string) (string, string) {
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return s[:i], s[i:]
+	}
+	return s, ""
+}
+
+type borderStyle struct {
+
+	// top, mid and bottom are the left edge, fill, column
+	// separator and right edge of the horizontal rules. The
+	// rule isn't drawn if the fill is empty.
+	top, mid, bottom [4]string
+
+	// left, sep and right are the left edge, column separator
+	// and right edge of the lines of each row.
+	left, sep, right string
+}
+
+var borderStyles = map[Border]borderStyle{
+	BorderNone: {
+		sep: "  ",
+	},
+	BorderASCII: {
+		top:    [4]string{"+", "-", "+", "+"},
+		mid:    [4]string{"+", "-", "+", "+"},
+		bottom: [4]string{"+", "-", "+", "+"},
+		left:   "| ",
+		sep:    " | ",
+		right:  " |",
+	},
+	BorderBox: {
+		top:    [4]string{"┌", "─", "┬", "┐"},
+		mid:    [4]string{"├", "─", "┼", "┤"},
+		bottom: [4]string{"└", "─", "┴", "┘"},
+		left:   "│ ",
+		sep:    " │ ",
+		right:  " │",
+	},
+	BorderMarkdown: {
+		left:  "| ",
+		sep:   " | ",
+		right: " |",
+	},
+}
+
+func (t *Table) writeRule(b *strings.Builder, rule [4]string, widths []int) {
+	if rule[1] == "" {
+		return
+	}
+	b.WriteString(rule[0])
+	for c, w := range widths {
+		if c > 0 {
+			b.WriteString(rule[2])
+		}
+		b.WriteString(strings.Repeat(rule[1], w+2))
+	}
+	b.WriteString(rule[3])
+	b.WriteByte('\n')
+}
+
+func (t *Table) writeMarkdownRule(b *strings.Builder, cols []Column, widths []int) {
+	for c, col := range cols {
+		if c == 0 {
+			b.WriteString("| ")
+		} else {
+			b.WriteString(" | ")
+		}
+		switch col.Align {
+		case AlignRight, AlignDecimal:
+			b.WriteString(strings.Repeat("-", widths[c]-1) + ":")
+		case AlignCenter:
+			b.WriteString(":" + strings.Repeat("-", widths[c]-2) + ":")
+		default:
+			b.WriteString(strings.Repeat("-", widths[c]))
+		}
+	}
+	b.WriteString(" |\n")
+}
+
+/*
+writeRow writes the lines of a row of cells, each of which is aligned
+within its column. Cells in decimal columns have already been padded
+so are aligned right.
+*/
+func (t *Table) writeRow(b *strings.Builder, st borderStyle, cells [][]string, cols []Column, widths []int) {
+
+	var height int
+	for _, lines := range cells {
+		height = max(height, len(lines))
+	}
+
+	for i := 0; i < height; i++ {
+
+		var line strings.Builder
+		line.WriteString(st.left)
+
+		for c, lines := range cells {
+
+			if c > 0 {
+				line.WriteString(st.sep)
+			}
+
+			var s string
+			if i < len(lines) {
+				s = lines[i]
+			}
+
+			a := cols[c].Align
+			if a == AlignDecimal {
+				a = AlignRight
+			}
+
+			pad, _ := padUnits(widths[c]-t.Unit.measure(s), ' ', t.Unit)
+			switch a {
+			case AlignRight:
+				line.WriteString(pad + s)
+			case AlignCenter:
+				line.WriteString(CenterBias(s, ' ', widths[c], t.Unit, BiasLeft))
+			default:
+				line.WriteString(s + pad)
+			}
+		}
+
+		line.WriteString(st.right)
+
+		if t.Border == BorderNone {
+			b.WriteString(strings.TrimRight(line.String(), " "))
+		} else {
+			b.WriteString(line.String())
+		}
+		b.WriteByte('\n')
+	}
+}
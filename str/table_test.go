@@ -0,0 +1,148 @@
+package str
+
+import (
+	"strings"
+	"testing"
+)
+
+func fruitTable(b Border) *Table {
+	t := NewTable("Fruit", "Price")
+	t.Columns[1].Align = AlignDecimal
+	t.Border = b
+	t.AddRow("Apple", "1.5")
+	t.AddRow("Watermelon", "12.25")
+	t.AddRow("Fig", "3")
+	return t
+}
+
+func TestTableBorders(t *testing.T) {
+
+	cases := []struct {
+		b    Border
+		want string
+	}{
+		{BorderNone, `
+Fruit       Price
+Apple        1.5
+Watermelon  12.25
+Fig          3
+`},
+		{BorderASCII, `
++------------+-------+
+| Fruit      | Price |
++------------+-------+
+| Apple      |  1.5  |
+| Watermelon | 12.25 |
+| Fig        |  3    |
++------------+-------+
+`},
+		{BorderBox, `
+┌────────────┬───────┐
+│ Fruit      │ Price │
+├────────────┼───────┤
+│ Apple      │  1.5  │
+│ Watermelon │ 12.25 │
+│ Fig        │  3    │
+└────────────┴───────┘
+`},
+		{BorderMarkdown, `
+| Fruit      | Price |
+| ---------- | ----: |
+| Apple      |  1.5  |
+| Watermelon | 12.25 |
+| Fig        |  3    |
+`},
+	}
+
+	for _, c := range cases {
+		want := strings.TrimPrefix(c.want, "\n")
+		if got := fruitTable(c.b).String(); got != want {
+			t.Errorf("Table with Border %d is\n%s\nwanted\n%s", c.b, got, want)
+		}
+	}
+}
+
+func TestTableColumns(t *testing.T) {
+
+	tbl := &Table{
+		Columns: []Column{
+			{Align: AlignRight},
+			{Align: AlignCenter},
+			{MaxWidth: 8},
+			{MaxWidth: 8, Wrap: true},
+		},
+		Border: BorderASCII,
+	}
+	tbl.AddRow("1", "a", "truncated text", "wrapped text here")
+	tbl.AddRow("100", "bcd", "short", "two\nlines", "extra")
+	tbl.AddRow()
+
+	want := `
++-----+-----+----------+---------+-------+
+|   1 |  a  | truncat… | wrapped |       |
+|     |     |          | text    |       |
+|     |     |          | here    |       |
+| 100 | bcd | short    | two     | extra |
+|     |     |          | lines   |       |
+|     |     |          |         |       |
++-----+-----+----------+---------+-------+
+`
+	want = strings.TrimPrefix(want, "\n")
+	if got := tbl.String(); got != want {
+		t.Errorf("Table is\n%s\nwanted\n%s", got, want)
+	}
+}
+
+func TestTableWidth(t *testing.T) {
+
+	tbl := NewTable("名前", "x")
+	tbl.Unit = ByWidth
+	tbl.Columns[0].MaxWidth = 6
+	tbl.AddRow("日本語テキスト", "1")
+	tbl.AddRow("ab", "2")
+
+	want := `
+名前   x
+日本…  1
+ab     2
+`
+	want = strings.TrimPrefix(want, "\n")
+	if got := tbl.String(); got != want {
+		t.Errorf("Table is\n%s\nwanted\n%s", got, want)
+	}
+}
+
+func TestTableMarkdown(t *testing.T) {
+
+	tbl := &Table{Border: BorderMarkdown}
+	tbl.Columns = []Column{{Align: AlignCenter}, {}}
+	tbl.AddRow("a|b", "one\ntwo")
+
+	want := `
+|      |            |
+| :--: | ---------- |
+| a\|b | one<br>two |
+`
+	want = strings.TrimPrefix(want, "\n")
+	if got := tbl.String(); got != want {
+		t.Errorf("Table is\n%s\nwanted\n%s", got, want)
+	}
+}
+
+func TestTableWriteTo(t *testing.T) {
+
+	var b strings.Builder
+	tbl := fruitTable(BorderBox)
+
+	n, err := tbl.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("WriteTo returned error %v.", err)
+	}
+	if b.String() != tbl.String() || int(n) != b.Len() {
+		t.Errorf("WriteTo wrote %d bytes %q, wanted %q.", n, b.String(), tbl.String())
+	}
+
+	if got := (&Table{}).String(); got != "" {
+		t.Errorf("empty Table is %q, wanted \"\".", got)
+	}
+}
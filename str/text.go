@@ -0,0 +1,255 @@
+package str
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+Text is an immutable string indexed by rune, and optionally by
+grapheme cluster, so that its characters can be looked up without
+scanning the string each time. Functions such as Char and Slice take
+time proportional to the length of their input, so calling them in a
+loop over a long string is slow; the equivalent Text methods take
+constant time once the Text has been built.
+
+	t := str.NewText("Hello, 世界", false)
+	for i := 0; i < t.Len(); i++ {
+		c, _ := t.Char(i)
+		fmt.Println(c)
+	}
+
+Indices and their meanings, including negative ones, are the same as
+for the package level functions of the same names. A Text is safe for
+concurrent use.
+*/
+type Text struct {
+	s string
+
+	// offsets holds the byte offset of each rune in s followed
+	// by len(s). It is nil if s is ASCII, in which case rune
+	// and byte offsets are the same.
+	offsets []int
+
+	// graphemes holds the byte offset of each grapheme cluster
+	// in s followed by len(s), if grapheme indexing was
+	// requested.
+	graphemes []int
+}
+
+/*
+NewText returns a Text holding s. If graphemes is true it also
+indexes s by grapheme cluster, which makes the Text's grapheme
+methods take constant time too.
+*/
+func NewText(s string, graphemes bool) *Text {
+
+	t := &Text{s: s}
+
+	if !isASCII(s) {
+		t.offsets = make([]int, 0, len(s)+1)
+		for i := range s {
+			t.offsets = append(t.offsets, i)
+		}
+		t.offsets = append(t.offsets, len(s))
+	}
+
+	if graphemes {
+		t.graphemes = graphemeOffsets(s)
+	}
+
+	return t
+}
+
+func graphemeOffsets(s string) []int {
+	offsets := make([]int, 0, len(s)/2+1)
+	for i := 0; i < len(s); i += nextGrapheme(s[i:]) {
+		offsets = append(offsets, i)
+	}
+	return append(offsets, len(s))
+}
+
+/*
+String returns the string t holds.
+*/
+func (t *Text) String() string {
+	return t.s
+}
+
+/*
+Len returns the number of runes in t.
+*/
+func (t *Text) Len() int {
+	if t.offsets == nil {
+		return len(t.s)
+	}
+	return len(t.offsets) - 1
+}
+
+/*
+ByteOffset returns the byte offset in t of the rune at index i. An
+index equal to Len returns the length of t in bytes. It returns -1 if
+i is out of range.
+*/
+func (t *Text) ByteOffset(i int) int {
+	if i < 0 || i > t.Len() {
+		return -1
+	}
+	if t.offsets == nil {
+		return i
+	}
+	return t.offsets[i]
+}
+
+/*
+RuneIndex returns the index of the rune in t that contains the byte
+at offset b. An offset equal to the length of t in bytes returns Len.
+It returns -1 if b is out of range.
+*/
+func (t *Text) RuneIndex(b int) int {
+	if b < 0 || b > len(t.s) {
+		return -1
+	}
+	if t.offsets == nil {
+		return b
+	}
+	i := sort.SearchInts(t.offsets, b)
+	if t.offsets[i] != b {
+		i--
+	}
+	return i
+}
+
+/*
+Char returns rune i of t. See the package level Char.
+*/
+func (t *Text) Char(i int) (string, error) {
+	return t.Slice(i, i+1)
+}
+
+/*
+Slice returns a substring of t between rune indices start and end.
+See the package level Slice.
+*/
+func (t *Text) Slice(start, end int) (string, error) {
+	return sliceOffsets(t.s, t.offsets, t.Len(), start, end)
+}
+
+/*
+Nth returns the rune index of the nth instance of subStr in t. See
+the package level Nth.
+*/
+func (t *Text) Nth(subStr string, n int) int {
+
+	if n == 0 {
+		return -1
+	}
+	if subStr == "" {
+		l := t.Len()
+		if abs(n) > l+1 {
+			return -1
+		}
+		if n < 0 {
+			return l + n + 1
+		}
+		return n - 1
+	}
+
+	// Instances may overlap, so each search resumes one rune
+	// on from the start of the previous instance.
+	var seen int
+	if n > 0 {
+		for from := 0; from < len(t.s); {
+			i := strings.Index(t.s[from:], subStr)
+			if i < 0 {
+				return -1
+			}
+			i += from
+			if seen++; seen == n {
+				return t.RuneIndex(i)
+			}
+			_, size := utf8.DecodeRuneInString(t.s[i:])
+			from = i + size
+		}
+		return -1
+	}
+
+	for to := len(t.s); to > 0; {
+		i := strings.LastIndex(t.s[:to], subStr)
+		if i < 0 {
+			return -1
+		}
+		if seen++; seen == -n {
+			return t.RuneIndex(i)
+		}
+		to = i + len(subStr) - 1
+	}
+	return -1
+}
+
+/*
+GraphemeLen returns the number of grapheme clusters in t. If t was
+not built with grapheme indexing it takes time proportional to the
+length of t.
+*/
+func (t *Text) GraphemeLen() int {
+	if t.graphemes == nil {
+		return GraphemeLen(t.s)
+	}
+	return len(t.graphemes) - 1
+}
+
+/*
+Grapheme returns grapheme cluster i of t. Negative values of i are
+treated as an offset from the end of t. See the package level
+SliceGraphemes.
+*/
+func (t *Text) Grapheme(i int) (string, error) {
+	return t.SliceGraphemes(i, i+1)
+}
+
+/*
+SliceGraphemes returns a substring of t between grapheme cluster
+indices start and end. See the package level SliceGraphemes. If t was
+not built with grapheme indexing it takes time proportional to the
+length of t.
+*/
+func (t *Text) SliceGraphemes(start, end int) (string, error) {
+	offsets := t.graphemes
+	if offsets == nil {
+		offsets = graphemeOffsets(t.s)
+	}
+	return sliceOffsets(t.s, offsets, len(offsets)-1, start, end)
+}
+
+/*
+sliceOffsets implements Slice for a string of n units starting at the
+given byte offsets, or at offsets equal to their indices if offsets
+is nil.
+*/
+func sliceOffsets(s string, offsets []int, n, start, end int) (string, error) {
+
+	if abs(start) > n || abs(end) > n {
+		return "", errors.New("index out of bounds")
+	}
+	if start < 0 {
+		start = n + start
+	}
+	if end < 0 {
+		end = n + end
+	}
+
+	at := func(i int) int {
+		if offsets == nil {
+			return i
+		}
+		return offsets[i]
+	}
+
+	if start > end {
+		return s[at(start):] + s[:at(end)], nil
+	}
+	return s[at(start):at(end)], nil
+}
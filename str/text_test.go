@@ -0,0 +1,109 @@
+package str
+
+import "testing"
+
+var textStrings = []string{
+	"",
+	"Hello",
+	"Hello, 世界",
+	"naïve café",
+	"aaaa",
+	"🇬🇧🇫🇷 é",
+	"한국어 text",
+}
+
+func TestTextSlice(t *testing.T) {
+	for _, s := range textStrings {
+		for _, graphemes := range []bool{false, true} {
+			txt := NewText(s, graphemes)
+			if txt.String() != s {
+				t.Errorf("NewText(%q, %v).String() return %q, wanted %q.", s, graphemes, txt.String(), s)
+			}
+			if got, want := txt.Len(), Len(s); got != want {
+				t.Errorf("NewText(%q, %v).Len() return %d, wanted %d.", s, graphemes, got, want)
+			}
+			if got, want := txt.GraphemeLen(), GraphemeLen(s); got != want {
+				t.Errorf("NewText(%q, %v).GraphemeLen() return %d, wanted %d.", s, graphemes, got, want)
+			}
+			n := Len(s)
+			for start := -n - 1; start <= n+1; start++ {
+				for end := -n - 1; end <= n+1; end++ {
+					got, gotErr := txt.Slice(start, end)
+					want, wantErr := Slice(s, start, end)
+					if got != want || (gotErr == nil) != (wantErr == nil) {
+						t.Errorf("NewText(%q, %v).Slice(%d, %d) return %q, %v, wanted %q, %v.", s, graphemes, start, end, got, gotErr, want, wantErr)
+					}
+				}
+				got, gotErr := txt.Char(start)
+				want, wantErr := Char(s, start)
+				if got != want || (gotErr == nil) != (wantErr == nil) {
+					t.Errorf("NewText(%q, %v).Char(%d) return %q, %v, wanted %q, %v.", s, graphemes, start, got, gotErr, want, wantErr)
+				}
+			}
+			g := GraphemeLen(s)
+			for start := -g - 1; start <= g+1; start++ {
+				for end := -g - 1; end <= g+1; end++ {
+					got, gotErr := txt.SliceGraphemes(start, end)
+					want, wantErr := SliceGraphemes(s, start, end)
+					if got != want || (gotErr == nil) != (wantErr == nil) {
+						t.Errorf("NewText(%q, %v).SliceGraphemes(%d, %d) return %q, %v, wanted %q, %v.", s, graphemes, start, end, got, gotErr, want, wantErr)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTextNth(t *testing.T) {
+
+	subStrs := []string{"", "a", "aa", "l", "世界", "é", "e\u0301", "🇫🇷", "text", "x"}
+
+	for _, s := range textStrings {
+		txt := NewText(s, false)
+		for _, sub := range subStrs {
+			for n := -6; n <= 6; n++ {
+				if got, want := txt.Nth(sub, n), Nth(s, sub, n); got != want {
+					t.Errorf("NewText(%q, false).Nth(%q, %d) return %d, wanted %d.", s, sub, n, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTextOffsets(t *testing.T) {
+
+	txt := NewText("aé世🙂b", false)
+
+	byteOffsets := []int{0, 1, 3, 6, 10, 11}
+	for i, want := range byteOffsets {
+		if got := txt.ByteOffset(i); got != want {
+			t.Errorf("ByteOffset(%d) return %d, wanted %d.", i, got, want)
+		}
+	}
+
+	runeIndices := []int{0, 1, 1, 2, 2, 2, 3, 3, 3, 3, 4, 5}
+	for b, want := range runeIndices {
+		if got := txt.RuneIndex(b); got != want {
+			t.Errorf("RuneIndex(%d) return %d, wanted %d.", b, got, want)
+		}
+	}
+
+	for _, i := range []int{-1, 6} {
+		if got := txt.ByteOffset(i); got != -1 {
+			t.Errorf("ByteOffset(%d) return %d, wanted -1.", i, got)
+		}
+	}
+	for _, b := range []int{-1, 12} {
+		if got := txt.RuneIndex(b); got != -1 {
+			t.Errorf("RuneIndex(%d) return %d, wanted -1.", b, got)
+		}
+	}
+
+	ascii := NewText("abc", false)
+	if got := ascii.ByteOffset(3); got != 3 {
+		t.Errorf("ByteOffset(3) return %d, wanted 3.", got)
+	}
+	if got := ascii.RuneIndex(2); got != 2 {
+		t.Errorf("RuneIndex(2) return %d, wanted 2.", got)
+	}
+}
@@ -0,0 +1,62 @@
+package str
+
+import "unicode/utf8"
+
+/*
+Token is a word found in a string along with its position in that
+string. Start and End are byte offsets such that s[Start:End] is
+Text, while RuneStart and RuneEnd are the equivalent rune offsets
+suitable for use with Slice.
+*/
+type Token struct {
+	Text      string
+	Start     int
+	End       int
+	RuneStart int
+	RuneEnd   int
+}
+
+/*
+Tokens returns the words in s along with their positions in s. The
+tokens are exactly those returned by Words, in the same order.
+
+	tt := str.Tokens(`"Hi," said 世界.`)
+	// tt is []Token{
+	// 	{Text: "Hi", Start: 1, End: 3, RuneStart: 1, RuneEnd: 3},
+	// 	{Text: "said", Start: 6, End: 10, RuneStart: 6, RuneEnd: 10},
+	// 	{Text: "世界", Start: 11, End: 17, RuneStart: 11, RuneEnd: 13},
+	// }
+
+If s contains no words the slice will be non-nil and zero length.
+*/
+func Tokens(s string) []Token {
+	return defaultTokenizer.Tokens(s)
+}
+
+/*
+Tokens returns the words in s along with their positions in s. The
+tokens are exactly those returned by Words, in the same order.
+*/
+func (t *Tokenizer) Tokens(s string) []Token {
+
+	tokens := make([]Token, 0, len(s)/6)
+
+	// Rune offsets are counted incrementally from the end
+	// of the previous token so s is only traversed once.
+	var prevEnd, runePos int
+
+	t.scan(s, func(start, end int) {
+		runeStart := runePos + utf8.RuneCountInString(s[prevEnd:start])
+		runeEnd := runeStart + utf8.RuneCountInString(s[start:end])
+		tokens = append(tokens, Token{
+			Text:      s[start:end],
+			Start:     start,
+			End:       end,
+			RuneStart: runeStart,
+			RuneEnd:   runeEnd,
+		})
+		prevEnd, runePos = end, runeEnd
+	})
+
+	return tokens
+}
@@ -0,0 +1,70 @@
+package str
+
+import "testing"
+
+func TestTokens(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want []Token
+	}{
+		{
+			`"Hi," said 世界.`,
+			[]Token{
+				{Text: "Hi", Start: 1, End: 3, RuneStart: 1, RuneEnd: 3},
+				{Text: "said", Start: 6, End: 10, RuneStart: 6, RuneEnd: 10},
+				{Text: "世界", Start: 11, End: 17, RuneStart: 11, RuneEnd: 13},
+			},
+		},
+		{
+			"💩 it's—done!",
+			[]Token{
+				{Text: "💩", Start: 0, End: 4, RuneStart: 0, RuneEnd: 1},
+				{Text: "it's", Start: 5, End: 9, RuneStart: 2, RuneEnd: 6},
+				{Text: "done", Start: 12, End: 16, RuneStart: 7, RuneEnd: 11},
+			},
+		},
+		{"", []Token{}},
+		{"\n\n ,, \n", []Token{}},
+	}
+
+	for _, c := range cases {
+		got := Tokens(c.s)
+		if len(got) != len(c.want) {
+			t.Errorf("Tokens(%q) return %v, wanted %v.", c.s, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("Tokens(%q)[%d] is %+v, wanted %+v.", c.s, i, got[i], c.want[i])
+			}
+		}
+	}
+}
+
+func TestTokensMatchWords(t *testing.T) {
+
+	ss := []string{
+		`"Here's the dialogue," said the narrator/programmer to the listener!! And here's this.`,
+		"hello I am poop 💩 that's my face",
+		"Status::(happy) 世界 世界世界",
+		"interrupted\n\n\nstring.\n\n\n",
+	}
+
+	for _, s := range ss {
+		words := Words(s)
+		tokens := Tokens(s)
+		if len(words) != len(tokens) {
+			t.Errorf("len(Tokens(%q)) is %d, len(Words(%q)) is %d.", s, len(tokens), s, len(words))
+			continue
+		}
+		for i, tok := range tokens {
+			if tok.Text != words[i] || s[tok.Start:tok.End] != words[i] {
+				t.Errorf("Tokens(%q)[%d] is %+v, wanted word %q.", s, i, tok, words[i])
+			}
+			if got, _ := Slice(s, tok.RuneStart, tok.RuneEnd); got != words[i] {
+				t.Errorf("Slice(%q, %d, %d) is %q, wanted %q.", s, tok.RuneStart, tok.RuneEnd, got, words[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+DefaultGrammar holds the grammatical marks used by Words. Grammar
+adjacent to a word boundary is omitted from words.
+*/
+const DefaultGrammar = `!?,.'"[]()*~{}:;-<>+=|%&@#$^\` + "`"
+
+/*
+DefaultBoundaries holds the characters, in addition to spaces, that
+separate words in Words: endashes, emdashes, and forward slashes.
+*/
+const DefaultBoundaries = "–—/"
+
+/*
+Tokenizer splits strings into words according to configurable rules.
+Words are separated by any space character (as defined by Unicode)
+as well as any boundary character. Grammatical marks adjacent to a
+boundary, or at the start or end of s, are omitted from words.
+
+The package level functions Words, WordCount, WordSet and
+WordsByOccurrence use a Tokenizer with Grammar set to DefaultGrammar
+and Boundaries set to DefaultBoundaries. A zero Tokenizer splits
+words on spaces only and treats nothing as grammar.
+
+For example, to keep file paths together as single words:
+
+	t := str.Tokenizer{
+		Grammar:    str.DefaultGrammar,
+		Boundaries: "–—",
+	}
+	ww := t.Words("see /usr/local/bin.")
+	// ww is []string{"see", "/usr/local/bin"}
+*/
+type Tokenizer struct {
+	// Grammar holds the runes treated as grammatical marks.
+	Grammar string
+
+	// Boundaries holds the runes, other than spaces, that
+	// separate words.
+	Boundaries string
+
+	// IsGrammar, if non-nil, reports whether a rune not in
+	// Grammar should also be treated as a grammatical mark.
+	IsGrammar func(r rune) bool
+
+	// IsBoundary, if non-nil, reports whether a rune not in
+	// Boundaries should also separate words.
+	IsBoundary func(r rune) bool
+
+	// SplitInner causes grammar within a word to separate it
+	// into two words rather than being retained. For example,
+	// "here's" becomes "here" and "s".
+	SplitInner bool
+
+	// Stopwords, if non-nil, holds words that are omitted from
+	// all results, whatever their case. See StopwordSet.
+	Stopwords *StopwordSet
+}
+
+var defaultTokenizer = &Tokenizer{
+	Grammar:    DefaultGrammar,
+	Boundaries: DefaultBoundaries,
+}
+
+/*
+Words returns the words in s as a slice of strings in order of
+their appearance. If s contains no words the slice will be non-nil
+and zero length.
+
+See the package level Words for an example.
+*/
+func (t *Tokenizer) Words(s string) []string {
+
+	// Approximate how long our words slice will need to be
+	// to avoid repeated expansions.
+	avgWordLen := 5.5
+	words := make([]string, 0, int(float64(len(s))/avgWordLen))
+
+	t.scan(s, func(start, end int) {
+		words = append(words, s[start:end])
+	})
+
+	return words
+}
+
+/*
+WordCount returns the number of words in s.
+*/
+func (t *Tokenizer) WordCount(s string) int {
+	var count int
+	t.scan(s, func(start, end int) {
+		count++
+	})
+	return count
+}
+
+/*
+WordSet is the same as Words but removes duplicates from its results.
+See the package level WordSet for details of fold.
+*/
+func (t *Tokenizer) WordSet(s string, fold bool) []string {
+	return makeSet(t.Words(s), fold)
+}
+
+/*
+WordsByOccurrence returns an unordered OccMap where each index
+represents a single word and the number of times it appears in s.
+See the package level WordsByOccurrence for details of fold.
+*/
+func (t *Tokenizer) WordsByOccurrence(s string, fold bool) OccMap {
+	return occurrences(t.Words(s), fold)
+}
+
+/*
+scan calls fn with the start and end byte offsets of each word in s.
+A word is a run of runes between boundaries with any grammar at
+either end trimmed off. Stopwords are skipped.
+*/
+func (t *Tokenizer) scan(s string, fn func(start, end int)) {
+
+	i := 0
+	for i < len(s) {
+
+		// Skip boundaries.
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if t.splits(r) {
+			i += size
+			continue
+		}
+
+		// Find the end of this run of non-boundaries.
+		start := i
+		for i < len(s) {
+			r, size = utf8.DecodeRuneInString(s[i:])
+			if t.splits(r) {
+				break
+			}
+			i += size
+		}
+
+		start, end := t.trimGrammar(s, start, i)
+		if start < end && !t.Stopwords.Contains(s[start:end]) {
+			fn(start, end)
+		}
+	}
+}
+
+/*
+trimGrammar returns the offsets of s[start:end] with any grammar at
+either end removed.
+*/
+func (t *Tokenizer) trimGrammar(s string, start, end int) (int, int) {
+	for start < end {
+		r, size := utf8.DecodeRuneInString(s[start:end])
+		if !t.isGrammar(r) {
+			break
+		}
+		start += size
+	}
+	for start < end {
+		r, size := utf8.DecodeLastRuneInString(s[start:end])
+		if !t.isGrammar(r) {
+			break
+		}
+		end -= size
+	}
+	return start, end
+}
+
+// splits reports whether r ends a word.
+func (t *Tokenizer) splits(r rune) bool {
+	return t.isBoundary(r) || t.SplitInner && t.isGrammar(r)
+}
+
+func (t *Tokenizer) isGrammar(r rune) bool {
+	if strings.ContainsRune(t.Grammar, r) {
+		return true
+	}
+	return t.IsGrammar != nil && t.IsGrammar(r)
+}
+
+func (t *Tokenizer) isBoundary(r rune) bool {
+	if unicode.IsSpace(r) || strings.ContainsRune(t.Boundaries, r) {
+		return true
+	}
+	return t.IsBoundary != nil && t.IsBoundary(r)
+}
@@ -0,0 +1,89 @@
+package str
+
+import (
+	"sort"
+	"testing"
+	"unicode"
+)
+
+func TestTokenizerWords(t *testing.T) {
+
+	paths := &Tokenizer{
+		Grammar:    DefaultGrammar,
+		Boundaries: "–—",
+	}
+	identifiers := &Tokenizer{
+		Grammar: DefaultGrammar,
+		IsBoundary: func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+		},
+	}
+	split := &Tokenizer{
+		Grammar:    DefaultGrammar,
+		Boundaries: DefaultBoundaries,
+		SplitInner: true,
+	}
+	custom := &Tokenizer{
+		Grammar: DefaultGrammar,
+		IsGrammar: func(r rune) bool {
+			return r == '«' || r == '»'
+		},
+	}
+
+	cases := []struct {
+		t    *Tokenizer
+		s    string
+		want []string
+	}{
+		{paths, "see /usr/local/bin.", []string{"see", "/usr/local/bin"}},
+		{paths, "either/or—neither", []string{"either/or", "neither"}},
+		{identifiers, "call __init__(self, my_var2)", []string{"call", "__init__", "self", "my_var2"}},
+		{split, "here's Status::(happy", []string{"here", "s", "Status", "happy"}},
+		{custom, "«Voilà»!", []string{"Voilà"}},
+		{&Tokenizer{}, " a, b/c ", []string{"a,", "b/c"}},
+		{defaultTokenizer, `"here's an em—dash"`, []string{"here's", "an", "em", "dash"}},
+		{defaultTokenizer, "", []string{}},
+	}
+
+	for _, c := range cases {
+		if got := c.t.Words(c.s); !strSliceEqual(got, c.want) {
+			t.Errorf("Tokenizer.Words(%q) return %q, wanted %q.", c.s, got, c.want)
+		}
+		if got := c.t.WordCount(c.s); got != len(c.want) {
+			t.Errorf("Tokenizer.WordCount(%q) return %d, wanted %d.", c.s, got, len(c.want))
+		}
+	}
+}
+
+func TestTokenizerWordSet(t *testing.T) {
+
+	tk := &Tokenizer{Grammar: DefaultGrammar}
+	s := "Either/or, either/OR"
+
+	if got, want := tk.WordSet(s, false), []string{"Either/or", "either/OR"}; !strSliceEqual(got, want) {
+		t.Errorf("Tokenizer.WordSet(%q, false) return %q, wanted %q.", s, got, want)
+	}
+	if got, want := tk.WordSet(s, true), []string{"either/or"}; !strSliceEqual(got, want) {
+		t.Errorf("Tokenizer.WordSet(%q, true) return %q, wanted %q.", s, got, want)
+	}
+}
+
+func TestTokenizerWordsByOccurrence(t *testing.T) {
+
+	tk := &Tokenizer{Grammar: DefaultGrammar}
+	s := "a/b, A/B and a/b"
+	want := OccMap{
+		{SubStr: "a/b", N: 3},
+		{SubStr: "and", N: 1},
+	}
+
+	got := tk.WordsByOccurrence(s, true)
+	sort.Sort(got)
+	if !occSliceCorrect(got, want) {
+		t.Errorf(
+			"Tokenizer.WordsByOccurrence(%q)\n"+
+				"    return %v\n"+
+				"    wanted %v",
+			s, got, want)
+	}
+}
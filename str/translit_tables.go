@@ -0,0 +1,129 @@
+package str
+
+import "strings"
+
+/*
+asciiTable maps runes to their ASCII transliterations for ToASCII.
+It is built from the lists in translitLists, each of which pairs a
+run of runes with their space separated transliterations. An empty
+transliteration is written as "_".
+*/
+var asciiTable = func() map[rune]string {
+
+	m := make(map[rune]string, 512)
+	for _, l := range translitLists {
+		from := []rune(l.from)
+		to := strings.Fields(l.to)
+		if len(from) != len(to) {
+			panic("str: transliteration list for " + l.from + " is the wrong length")
+		}
+		for i, r := range from {
+			if to[i] == "_" {
+				to[i] = ""
+			}
+			m[r] = to[i]
+		}
+	}
+
+	// Vietnamese letters alternate between upper and lower case.
+	for _, v := range translitVietnamese {
+		for r := v.lo; r <= v.hi; r++ {
+			if (r-v.lo)%2 == 0 {
+				m[r] = v.upper
+			} else {
+				m[r] = strings.ToLower(v.upper)
+			}
+		}
+	}
+
+	return m
+}()
+
+var translitLists = []struct {
+	from, to string
+}{
+	// Latin-1 Supplement
+	{
+		"ÀÁÂÃÄÅÆÇÈÉÊËÌÍÎÏÐÑÒÓÔÕÖ×ØÙÚÛÜÝÞß",
+		"A A A A A A AE C E E E E I I I I D N O O O O O x O U U U U Y TH ss",
+	},
+	{
+		"àáâãäåæçèéêëìíîïðñòóôõö÷øùúûüýþÿ",
+		"a a a a a a ae c e e e e i i i i d n o o o o o / o u u u u y th y",
+	},
+
+	// Latin Extended-A
+	{
+		"ĀāĂăĄąĆćĈĉĊċČčĎďĐđĒēĔĕĖėĘęĚěĜĝĞğĠġĢģĤĥĦħĨĩĪīĬĭĮįİı",
+		"A a A a A a C c C c C c C c D d D d E e E e E e E e E e G g G g G g G g H h H h I i I i I i I i I i",
+	},
+	{
+		"ĲĳĴĵĶķĸĹĺĻļĽľĿŀŁłŃńŅņŇňŉŊŋŌōŎŏŐőŒœŔŕŖŗŘř",
+		"IJ ij J j K k k L l L l L l L l L l N n N n N n 'n N n O o O o O o OE oe R r R r R r",
+	},
+	{
+		"ŚśŜŝŞşŠšŢţŤťŦŧŨũŪūŬŭŮůŰűŲųŴŵŶŷŸŹźŻżŽžſ",
+		"S s S s S s S s T t T t T t U u U u U u U u U u U u W w Y y Y Z z Z z Z z s",
+	},
+
+	// Latin Extended-B
+	{
+		"ƀƁƇƈƉƊƑƒƓƗƘƙƚƝƞƟƠơƤƥƫƬƭƮƯưƲƳƴƵƶǍǎǏǐǑǒǓǔǕǖǗǘǙǚǛǜǞǟǤǥǦǧǨǩǪǫǴǵǸǹȘșȚțȞȟȤȥȦȧȨȩȪȫȮȯȲȳƏə",
+		"b B C c D D F f G I K k l N n O O o P p t T t T U u V Y y Z z A a I i O o U u U u U u U u U u A a G g G g K k O o G g N n S s T t H h Z z A a E e O o O o Y y E e",
+	},
+
+	// Greek
+	{
+		"ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩΆΈΉΊΌΎΏΪΫ",
+		"A V G D E Z I TH I K L M N X O P R S T Y F CH PS O A E I I O Y O I Y",
+	},
+	{
+		"αβγδεζηθικλμνξοπρσςτυφχψωάέήίόύώϊϋΐΰ",
+		"a v g d e z i th i k l m n x o p r s s t y f ch ps o a e i i o y o i y i y",
+	},
+
+	// Cyrillic
+	{
+		"АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ",
+		"A B V G D E Yo Zh Z I Y K L M N O P R S T U F Kh Ts Ch Sh Shch _ Y _ E Yu Ya",
+	},
+	{
+		"абвгдеёжзийклмнопрстуфхцчшщъыьэюя",
+		"a b v g d e yo zh z i y k l m n o p r s t u f kh ts ch sh shch _ y _ e yu ya",
+	},
+	{
+		"ЄІЇҐЎЂЈЉЊЋЏЃЌЅ",
+		"Ye I Yi G U Dj J Lj Nj C Dz Gj Kj Dz",
+	},
+	{
+		"єіїґўђјљњћџѓќѕ",
+		"ye i yi g u dj j lj nj c dz gj kj dz",
+	},
+
+	// Punctuation and symbols
+	{
+		"‘’‚‛“”„‟′″‹›«»–—―‐‑‒…•·¡¿¦§¶",
+		"' ' ' ' \" \" \" \" ' \" < > \" \" - - - - - - ... * . ! ? | S P",
+	},
+	{
+		"€£¥¢₹₽₩₺©®™°×÷±¹²³¼½¾µ",
+		"EUR GBP JPY c INR RUB KRW TRY (c) (r) (tm) deg x / +/- 1 2 3 1/4 1/2 3/4 u",
+	},
+}
+
+/*
+translitVietnamese holds runs of Vietnamese letters in Latin Extended
+Additional that alternate between the upper and lower case forms of
+a single base letter.
+*/
+var translitVietnamese = []struct {
+	lo, hi rune
+	upper  string
+}{
+	{0x1EA0, 0x1EB7, "A"},
+	{0x1EB8, 0x1EC7, "E"},
+	{0x1EC8, 0x1ECB, "I"},
+	{0x1ECC, 0x1EE3, "O"},
+	{0x1EE4, 0x1EF1, "U"},
+	{0x1EF2, 0x1EF9, "Y"},
+}
@@ -0,0 +1,160 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+Position is the part of a string that Truncate removes.
+*/
+type Position int
+
+const (
+	// TruncateEnd keeps the start of the string.
+	TruncateEnd Position = iota
+
+	// TruncateMiddle keeps the start and end of the string.
+	TruncateMiddle
+
+	// TruncateStart keeps the end of the string.
+	TruncateStart
+)
+
+/*
+TruncateOptions configures Truncate. The zero value removes the end
+of the string, measures by runes and marks the cut with "…".
+*/
+type TruncateOptions struct {
+	// Unit is the unit in which the limit is measured.
+	Unit Unit
+
+	// Ellipsis marks where text was removed. It defaults to
+	// "…". Its length counts towards the limit.
+	Ellipsis string
+
+	// NoEllipsis removes text without marking where.
+	NoEllipsis bool
+
+	// Position is the part of the string removed.
+	Position Position
+
+	// WordBoundary backs the cut off to the nearest boundary
+	// between words, as found by Words, so that no word is
+	// partly removed. If a single word is too long by itself
+	// it is cut between grapheme clusters instead.
+	WordBoundary bool
+}
+
+/*
+Truncate shortens s so that it is no longer than limit, measured in
+opts.Unit, replacing the text removed with an ellipsis. Strings that
+are short enough are returned unchanged. Grapheme clusters are never
+split, and spaces next to the ellipsis are removed. If limit is too
+small for even the ellipsis, as much of the ellipsis as fits is
+returned.
+
+	s := str.Truncate("Hello, world", 8, str.TruncateOptions{})
+	// s is "Hello,…"
+
+	s := str.Truncate("Hello, world", 8, str.TruncateOptions{WordBoundary: true})
+	// s is "Hello…"
+
+	s := str.Truncate("Hello, world", 8, str.TruncateOptions{Position: str.TruncateMiddle})
+	// s is "Hell…rld"
+*/
+func Truncate(s string, limit int, opts TruncateOptions) string {
+
+	u := opts.Unit
+	if u.measure(s) <= limit {
+		return s
+	}
+
+	ellipsis := opts.Ellipsis
+	if ellipsis == "" {
+		ellipsis = "…"
+	}
+	if opts.NoEllipsis {
+		ellipsis = ""
+	}
+
+	budget := limit - u.measure(ellipsis)
+	if budget < 0 {
+		offsets := graphemeOffsets(ellipsis)
+		return ellipsis[:prefixCut(ellipsis, offsets, max(limit, 0), u, nil)]
+	}
+
+	offsets := graphemeOffsets(s)
+
+	var words [][2]int
+	if opts.WordBoundary {
+		defaultTokenizer.scan(s, func(start, end int) {
+			words = append(words, [2]int{start, end})
+		})
+	}
+
+	var head, tail string
+	switch opts.Position {
+	case TruncateStart:
+		tail = s[suffixCut(s, offsets, budget, u, words):]
+	case TruncateMiddle:
+		head = s[:prefixCut(s, offsets, (budget+1)/2, u, words)]
+		tail = s[suffixCut(s, offsets, budget-u.measure(head), u, words):]
+	default:
+		head = s[:prefixCut(s, offsets, budget, u, words)]
+	}
+
+	head = strings.TrimRightFunc(head, unicode.IsSpace)
+	tail = strings.TrimLeftFunc(tail, unicode.IsSpace)
+	return head + ellipsis + tail
+}
+
+/*
+prefixCut returns the byte offset of the end of the longest prefix
+of s no longer than limit in u. Offsets are the byte offsets of the
+grapheme clusters in s, followed by len(s). Words holds the start and
+end byte offsets of the words in s, if any; the prefix is shortened
+to end at the end of one of them if possible.
+*/
+func prefixCut(s string, offsets []int, limit int, u Unit, words [][2]int) int {
+
+	cut, n := 0, 0
+	for i := 0; i < len(offsets)-1; i++ {
+		n += u.measure(s[offsets[i]:offsets[i+1]])
+		if n > limit {
+			break
+		}
+		cut = offsets[i+1]
+	}
+
+	for j := len(words) - 1; j >= 0; j-- {
+		if words[j][1] <= cut {
+			return words[j][1]
+		}
+	}
+	return cut
+}
+
+/*
+suffixCut is the same as prefixCut except that it returns the byte
+offset of the start of the longest suffix, shortened to start at the
+start of a word if possible.
+*/
+func suffixCut(s string, offsets []int, limit int, u Unit, words [][2]int) int {
+
+	cut, n := len(s), 0
+	for i := len(offsets) - 1; i > 0; i-- {
+		n += u.measure(s[offsets[i-1]:offsets[i]])
+		if n > limit {
+			break
+		}
+		cut = offsets[i-1]
+	}
+
+	for _, w := range words {
+		if w[0] >= cut {
+			return w[0]
+		}
+	}
+	return cut
+}
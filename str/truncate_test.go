@@ -0,0 +1,54 @@
+package str
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+
+	s := "Hello, world"
+
+	cases := []struct {
+		s     string
+		limit int
+		opts  TruncateOptions
+		want  string
+	}{
+		{s, 12, TruncateOptions{}, s},
+		{s, 20, TruncateOptions{}, s},
+		{s, 8, TruncateOptions{}, "Hello,…"},
+		{s, 9, TruncateOptions{}, "Hello, w…"},
+		{s, 8, TruncateOptions{WordBoundary: true}, "Hello…"},
+		{s, 8, TruncateOptions{Position: TruncateMiddle}, "Hell…rld"},
+		{s, 8, TruncateOptions{Position: TruncateStart}, "…, world"},
+		{s, 8, TruncateOptions{Position: TruncateStart, WordBoundary: true}, "…world"},
+		{s, 8, TruncateOptions{Ellipsis: "..."}, "Hello..."},
+		{s, 5, TruncateOptions{NoEllipsis: true}, "Hello"},
+
+		// The ellipsis is cut short if there's no room.
+		{s, 2, TruncateOptions{Ellipsis: "..."}, ".."},
+		{s, 1, TruncateOptions{}, "…"},
+		{s, 0, TruncateOptions{}, ""},
+
+		// Words too long to back off from are cut.
+		{"Supercalifragilistic word", 6, TruncateOptions{WordBoundary: true}, "Super…"},
+		{"one two three four", 12, TruncateOptions{Position: TruncateMiddle, WordBoundary: true}, "one…four"},
+
+		// Grapheme clusters are never split.
+		{"cafe\u0301s", 5, TruncateOptions{}, "caf…"},
+		{"cafe\u0301s", 5, TruncateOptions{Unit: ByGraphemes}, "cafe\u0301s"},
+		{"cafe\u0301s!", 5, TruncateOptions{Unit: ByGraphemes}, "cafe\u0301…"},
+		{"🇳🇿🇯🇵🇫🇷", 5, TruncateOptions{Unit: ByWidth}, "🇳🇿🇯🇵…"},
+		{"🇳🇿🇯🇵🇫🇷", 4, TruncateOptions{Unit: ByWidth}, "🇳🇿…"},
+		{"🇳🇿🇯🇵🇫🇷", 4, TruncateOptions{Unit: ByWidth, Position: TruncateStart}, "…🇫🇷"},
+
+		// Display width.
+		{"世界世界", 5, TruncateOptions{Unit: ByWidth}, "世界…"},
+		{"世界世界", 6, TruncateOptions{Unit: ByWidth, Position: TruncateMiddle}, "世…界"},
+		{"世界世界", 5, TruncateOptions{}, "世界世界"},
+	}
+
+	for _, c := range cases {
+		if got := Truncate(c.s, c.limit, c.opts); got != c.want {
+			t.Errorf("Truncate(%q, %d, %+v) return %q, wanted %q.", c.s, c.limit, c.opts, got, c.want)
+		}
+	}
+}
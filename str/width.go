@@ -0,0 +1,144 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Width returns the number of columns s occupies when displayed in a
+monospace terminal. East Asian wide and fullwidth characters as well
+as emoji count as two columns, while combining marks, zero width
+characters and control characters (including newlines and tabs)
+count as zero. Width is measured per grapheme cluster so that
+sequences such as flags and emoji joined with zero width joiners
+count as a single character.
+
+	w := str.Width("Hello") // 5
+	w := str.Width("世界")   // 4
+	w := str.Width("café")  // 4, even if é is written as e + U+0301
+*/
+func Width(s string) int {
+	var w int
+	for s != "" {
+		n := nextGrapheme(s)
+		w += graphemeWidth(s[:n])
+		s = s[n:]
+	}
+	return w
+}
+
+/*
+graphemeWidth returns the display width of the grapheme cluster g.
+A cluster is as wide as its first rune that has a width, except that
+an emoji presentation selector or a pair of regional indicators
+makes it two columns wide.
+*/
+func graphemeWidth(g string) int {
+
+	if len(g) == 1 {
+		return runeWidth(rune(g[0]))
+	}
+
+	var w int
+	for _, r := range g {
+		if w == 0 {
+			w = runeWidth(r)
+		}
+		if r == 0xFE0F || r >= 0x1F1E6 && r <= 0x1F1FF {
+			return 2
+		}
+	}
+	return w
+}
+
+/*
+runeWidth returns the number of columns r occupies when displayed
+on its own: 0, 1 or 2.
+*/
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20, r >= 0x7F && r < 0xA0:
+		return 0
+	case r < 0x300:
+		// The soft hyphen is conventionally displayed.
+		return 1
+	case r >= 0x1160 && r <= 0x11FF, r >= 0xD7B0 && r <= 0xD7FF:
+		// Hangul medial vowels and final consonants, which
+		// combine with a preceding leading consonant.
+		return 0
+	case r == 0x200B:
+		return 0
+	case unicode.Is(zeroWidth, r):
+		return 0
+	case unicode.Is(eastAsianWide, r):
+		return 2
+	}
+	return 1
+}
+
+/*
+PadLeftWidth prefixes s with padChar until s is width columns wide
+when displayed in a monospace terminal. See Width for how columns
+are counted.
+
+If padChar is wider than the remaining space it is made up with
+spaces instead. If padChar has no width s is returned unchanged.
+
+	s := str.PadLeftWidth("世界", ' ', 6) // "  世界"
+*/
+func PadLeftWidth(s string, padChar rune, width int) string {
+	pad, rem := padWidth(width-Width(s), padChar)
+	return rem + pad + s
+}
+
+/*
+PadRightWidth suffixes s with padChar until s is width columns wide
+when displayed in a monospace terminal. See PadLeftWidth for how
+wide padding characters are handled.
+*/
+func PadRightWidth(s string, padChar rune, width int) string {
+	pad, rem := padWidth(width-Width(s), padChar)
+	return s + pad + rem
+}
+
+/*
+PadToWidest suffixes each string in ss with padChar until it is as
+wide as the widest string in ss when displayed in a monospace terminal.
+It is the display width counterpart to PadToLongest.
+*/
+func PadToWidest(ss []string, padChar rune) []string {
+	var widest int
+	ww := make([]int, len(ss))
+	for i := range ss {
+		ww[i] = Width(ss[i])
+		if ww[i] > widest {
+			widest = ww[i]
+		}
+	}
+	for i := range ss {
+		pad, rem := padWidth(widest-ww[i], padChar)
+		ss[i] += pad + rem
+	}
+	return ss
+}
+
+/*
+padWidth returns a run of padChar as close to diff columns wide
+as possible along with the spaces needed to make up the remainder.
+Callers place the remainder on the outside edge of the padding.
+*/
+func padWidth(diff int, padChar rune) (pad, rem string) {
+
+	if diff <= 0 {
+		return "", ""
+	}
+
+	pw := runeWidth(padChar)
+	if pw == 0 || !utf8.ValidRune(padChar) {
+		return "", ""
+	}
+
+	return strings.Repeat(string(padChar), diff/pw), strings.Repeat(" ", diff%pw)
+}
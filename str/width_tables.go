@@ -0,0 +1,475 @@
+package str
+
+import "unicode"
+
+/*
+eastAsianWide holds the code points whose East_Asian_Width property
+is Wide (W) or Fullwidth (F) in Unicode 15.0's EastAsianWidth.txt.
+These occupy two columns in a monospace terminal. Ambiguous (A)
+code points are treated as narrow.
+*/
+var eastAsianWide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115F, 1},
+		{0x231A, 0x231B, 1},
+		{0x2329, 0x232A, 1},
+		{0x23E9, 0x23EC, 1},
+		{0x23F0, 0x23F0, 1},
+		{0x23F3, 0x23F3, 1},
+		{0x25FD, 0x25FE, 1},
+		{0x2614, 0x2615, 1},
+		{0x2648, 0x2653, 1},
+		{0x267F, 0x267F, 1},
+		{0x2693, 0x2693, 1},
+		{0x26A1, 0x26A1, 1},
+		{0x26AA, 0x26AB, 1},
+		{0x26BD, 0x26BE, 1},
+		{0x26C4, 0x26C5, 1},
+		{0x26CE, 0x26CE, 1},
+		{0x26D4, 0x26D4, 1},
+		{0x26EA, 0x26EA, 1},
+		{0x26F2, 0x26F3, 1},
+		{0x26F5, 0x26F5, 1},
+		{0x26FA, 0x26FA, 1},
+		{0x26FD, 0x26FD, 1},
+		{0x2705, 0x2705, 1},
+		{0x270A, 0x270B, 1},
+		{0x2728, 0x2728, 1},
+		{0x274C, 0x274C, 1},
+		{0x274E, 0x274E, 1},
+		{0x2753, 0x2755, 1},
+		{0x2757, 0x2757, 1},
+		{0x2795, 0x2797, 1},
+		{0x27B0, 0x27B0, 1},
+		{0x27BF, 0x27BF, 1},
+		{0x2B1B, 0x2B1C, 1},
+		{0x2B50, 0x2B50, 1},
+		{0x2B55, 0x2B55, 1},
+		{0x2E80, 0x2E99, 1},
+		{0x2E9B, 0x2EF3, 1},
+		{0x2F00, 0x2FD5, 1},
+		{0x2FF0, 0x2FFB, 1},
+		{0x3000, 0x303E, 1},
+		{0x3041, 0x3096, 1},
+		{0x3099, 0x30FF, 1},
+		{0x3105, 0x312F, 1},
+		{0x3131, 0x318E, 1},
+		{0x3190, 0x31E3, 1},
+		{0x31F0, 0x321E, 1},
+		{0x3220, 0x3247, 1},
+		{0x3250, 0x4DBF, 1},
+		{0x4E00, 0xA48C, 1},
+		{0xA490, 0xA4C6, 1},
+		{0xA960, 0xA97C, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xF900, 0xFAFF, 1},
+		{0xFE10, 0xFE19, 1},
+		{0xFE30, 0xFE52, 1},
+		{0xFE54, 0xFE66, 1},
+		{0xFE68, 0xFE6B, 1},
+		{0xFF01, 0xFF60, 1},
+		{0xFFE0, 0xFFE6, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16FE0, 0x16FE4, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x17000, 0x187F7, 1},
+		{0x18800, 0x18CD5, 1},
+		{0x18D00, 0x18D08, 1},
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B122, 1},
+		{0x1B132, 0x1B132, 1},
+		{0x1B150, 0x1B152, 1},
+		{0x1B155, 0x1B155, 1},
+		{0x1B164, 0x1B167, 1},
+		{0x1B170, 0x1B2FB, 1},
+		{0x1F004, 0x1F004, 1},
+		{0x1F0CF, 0x1F0CF, 1},
+		{0x1F18E, 0x1F18E, 1},
+		{0x1F191, 0x1F19A, 1},
+		{0x1F200, 0x1F202, 1},
+		{0x1F210, 0x1F23B, 1},
+		{0x1F240, 0x1F248, 1},
+		{0x1F250, 0x1F251, 1},
+		{0x1F260, 0x1F265, 1},
+		{0x1F300, 0x1F320, 1},
+		{0x1F32D, 0x1F335, 1},
+		{0x1F337, 0x1F37C, 1},
+		{0x1F37E, 0x1F393, 1},
+		{0x1F3A0, 0x1F3CA, 1},
+		{0x1F3CF, 0x1F3D3, 1},
+		{0x1F3E0, 0x1F3F0, 1},
+		{0x1F3F4, 0x1F3F4, 1},
+		{0x1F3F8, 0x1F43E, 1},
+		{0x1F440, 0x1F440, 1},
+		{0x1F442, 0x1F4FC, 1},
+		{0x1F4FF, 0x1F53D, 1},
+		{0x1F54B, 0x1F54E, 1},
+		{0x1F550, 0x1F567, 1},
+		{0x1F57A, 0x1F57A, 1},
+		{0x1F595, 0x1F596, 1},
+		{0x1F5A4, 0x1F5A4, 1},
+		{0x1F5FB, 0x1F64F, 1},
+		{0x1F680, 0x1F6C5, 1},
+		{0x1F6CC, 0x1F6CC, 1},
+		{0x1F6D0, 0x1F6D2, 1},
+		{0x1F6D5, 0x1F6D7, 1},
+		{0x1F6DC, 0x1F6DF, 1},
+		{0x1F6EB, 0x1F6EC, 1},
+		{0x1F6F4, 0x1F6FC, 1},
+		{0x1F7E0, 0x1F7EB, 1},
+		{0x1F7F0, 0x1F7F0, 1},
+		{0x1F90C, 0x1F93A, 1},
+		{0x1F93C, 0x1F945, 1},
+		{0x1F947, 0x1F9FF, 1},
+		{0x1FA70, 0x1FA7C, 1},
+		{0x1FA80, 0x1FA88, 1},
+		{0x1FA90, 0x1FABD, 1},
+		{0x1FABF, 0x1FAC5, 1},
+		{0x1FACE, 0x1FADB, 1},
+		{0x1FAE0, 0x1FAE8, 1},
+		{0x1FAF0, 0x1FAF8, 1},
+		{0x20000, 0x2FFFD, 1},
+		{0x30000, 0x3FFFD, 1},
+	},
+}
+
+/*
+zeroWidth holds the nonspacing and enclosing combining marks (Mn and
+Me) and format characters (Cf) of Unicode 15.0, which occupy no
+columns of their own.
+*/
+var zeroWidth = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00AD, 0x00AD, 1},
+		{0x0300, 0x036F, 1},
+		{0x0483, 0x0489, 1},
+		{0x0591, 0x05BD, 1},
+		{0x05BF, 0x05BF, 1},
+		{0x05C1, 0x05C2, 1},
+		{0x05C4, 0x05C5, 1},
+		{0x05C7, 0x05C7, 1},
+		{0x0600, 0x0605, 1},
+		{0x0610, 0x061A, 1},
+		{0x061C, 0x061C, 1},
+		{0x064B, 0x065F, 1},
+		{0x0670, 0x0670, 1},
+		{0x06D6, 0x06DD, 1},
+		{0x06DF, 0x06E4, 1},
+		{0x06E7, 0x06E8, 1},
+		{0x06EA, 0x06ED, 1},
+		{0x070F, 0x0711, 2},
+		{0x0730, 0x074A, 1},
+		{0x07A6, 0x07B0, 1},
+		{0x07EB, 0x07F3, 1},
+		{0x07FD, 0x07FD, 1},
+		{0x0816, 0x0819, 1},
+		{0x081B, 0x0823, 1},
+		{0x0825, 0x0827, 1},
+		{0x0829, 0x082D, 1},
+		{0x0859, 0x085B, 1},
+		{0x0890, 0x0891, 1},
+		{0x0898, 0x089F, 1},
+		{0x08CA, 0x0902, 1},
+		{0x093A, 0x093C, 2},
+		{0x0941, 0x0948, 1},
+		{0x094D, 0x094D, 1},
+		{0x0951, 0x0957, 1},
+		{0x0962, 0x0963, 1},
+		{0x0981, 0x09BC, 59},
+		{0x09C1, 0x09C4, 1},
+		{0x09CD, 0x09CD, 1},
+		{0x09E2, 0x09E3, 1},
+		{0x09FE, 0x09FE, 1},
+		{0x0A01, 0x0A02, 1},
+		{0x0A3C, 0x0A3C, 1},
+		{0x0A41, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A51, 1},
+		{0x0A70, 0x0A71, 1},
+		{0x0A75, 0x0A75, 1},
+		{0x0A81, 0x0A82, 1},
+		{0x0ABC, 0x0ABC, 1},
+		{0x0AC1, 0x0AC5, 1},
+		{0x0AC7, 0x0AC8, 1},
+		{0x0ACD, 0x0ACD, 1},
+		{0x0AE2, 0x0AE3, 1},
+		{0x0AFA, 0x0AFF, 1},
+		{0x0B01, 0x0B3C, 59},
+		{0x0B3F, 0x0B3F, 1},
+		{0x0B41, 0x0B44, 1},
+		{0x0B4D, 0x0B4D, 1},
+		{0x0B55, 0x0B56, 1},
+		{0x0B62, 0x0B63, 1},
+		{0x0B82, 0x0BC0, 62},
+		{0x0BCD, 0x0C00, 51},
+		{0x0C04, 0x0C3C, 56},
+		{0x0C3E, 0x0C40, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C62, 0x0C63, 1},
+		{0x0C81, 0x0CBC, 59},
+		{0x0CBF, 0x0CC6, 7},
+		{0x0CCC, 0x0CCD, 1},
+		{0x0CE2, 0x0CE3, 1},
+		{0x0D00, 0x0D01, 1},
+		{0x0D3B, 0x0D3C, 1},
+		{0x0D41, 0x0D44, 1},
+		{0x0D4D, 0x0D4D, 1},
+		{0x0D62, 0x0D63, 1},
+		{0x0D81, 0x0DCA, 73},
+		{0x0DD2, 0x0DD4, 1},
+		{0x0DD6, 0x0E31, 91},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E47, 0x0E4E, 1},
+		{0x0EB1, 0x0EB1, 1},
+		{0x0EB4, 0x0EBC, 1},
+		{0x0EC8, 0x0ECE, 1},
+		{0x0F18, 0x0F19, 1},
+		{0x0F35, 0x0F39, 2},
+		{0x0F71, 0x0F7E, 1},
+		{0x0F80, 0x0F84, 1},
+		{0x0F86, 0x0F87, 1},
+		{0x0F8D, 0x0F97, 1},
+		{0x0F99, 0x0FBC, 1},
+		{0x0FC6, 0x0FC6, 1},
+		{0x102D, 0x1030, 1},
+		{0x1032, 0x1037, 1},
+		{0x1039, 0x103A, 1},
+		{0x103D, 0x103E, 1},
+		{0x1058, 0x1059, 1},
+		{0x105E, 0x1060, 1},
+		{0x1071, 0x1074, 1},
+		{0x1082, 0x1082, 1},
+		{0x1085, 0x1086, 1},
+		{0x108D, 0x109D, 16},
+		{0x135D, 0x135F, 1},
+		{0x1712, 0x1714, 1},
+		{0x1732, 0x1733, 1},
+		{0x1752, 0x1753, 1},
+		{0x1772, 0x1773, 1},
+		{0x17B4, 0x17B5, 1},
+		{0x17B7, 0x17BD, 1},
+		{0x17C6, 0x17C6, 1},
+		{0x17C9, 0x17D3, 1},
+		{0x17DD, 0x17DD, 1},
+		{0x180B, 0x180F, 1},
+		{0x1885, 0x1886, 1},
+		{0x18A9, 0x18A9, 1},
+		{0x1920, 0x1922, 1},
+		{0x1927, 0x1928, 1},
+		{0x1932, 0x1932, 1},
+		{0x1939, 0x193B, 1},
+		{0x1A17, 0x1A18, 1},
+		{0x1A1B, 0x1A56, 59},
+		{0x1A58, 0x1A5E, 1},
+		{0x1A60, 0x1A62, 2},
+		{0x1A65, 0x1A6C, 1},
+		{0x1A73, 0x1A7C, 1},
+		{0x1A7F, 0x1A7F, 1},
+		{0x1AB0, 0x1ACE, 1},
+		{0x1B00, 0x1B03, 1},
+		{0x1B34, 0x1B34, 1},
+		{0x1B36, 0x1B3A, 1},
+		{0x1B3C, 0x1B42, 6},
+		{0x1B6B, 0x1B73, 1},
+		{0x1B80, 0x1B81, 1},
+		{0x1BA2, 0x1BA5, 1},
+		{0x1BA8, 0x1BA9, 1},
+		{0x1BAB, 0x1BAD, 1},
+		{0x1BE6, 0x1BE6, 1},
+		{0x1BE8, 0x1BE9, 1},
+		{0x1BED, 0x1BED, 1},
+		{0x1BEF, 0x1BF1, 1},
+		{0x1C2C, 0x1C33, 1},
+		{0x1C36, 0x1C37, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CE0, 1},
+		{0x1CE2, 0x1CE8, 1},
+		{0x1CED, 0x1CF4, 7},
+		{0x1CF8, 0x1CF9, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x200B, 0x200F, 1},
+		{0x202A, 0x202E, 1},
+		{0x2060, 0x2064, 1},
+		{0x2066, 0x206F, 1},
+		{0x20D0, 0x20F0, 1},
+		{0x2CEF, 0x2CF1, 1},
+		{0x2D7F, 0x2D7F, 1},
+		{0x2DE0, 0x2DFF, 1},
+		{0x302A, 0x302D, 1},
+		{0x3099, 0x309A, 1},
+		{0xA66F, 0xA672, 1},
+		{0xA674, 0xA67D, 1},
+		{0xA69E, 0xA69F, 1},
+		{0xA6F0, 0xA6F1, 1},
+		{0xA802, 0xA806, 4},
+		{0xA80B, 0xA80B, 1},
+		{0xA825, 0xA826, 1},
+		{0xA82C, 0xA82C, 1},
+		{0xA8C4, 0xA8C5, 1},
+		{0xA8E0, 0xA8F1, 1},
+		{0xA8FF, 0xA8FF, 1},
+		{0xA926, 0xA92D, 1},
+		{0xA947, 0xA951, 1},
+		{0xA980, 0xA982, 1},
+		{0xA9B3, 0xA9B3, 1},
+		{0xA9B6, 0xA9B9, 1},
+		{0xA9BC, 0xA9BD, 1},
+		{0xA9E5, 0xA9E5, 1},
+		{0xAA29, 0xAA2E, 1},
+		{0xAA31, 0xAA32, 1},
+		{0xAA35, 0xAA36, 1},
+		{0xAA43, 0xAA4C, 9},
+		{0xAA7C, 0xAAB0, 52},
+		{0xAAB2, 0xAAB4, 1},
+		{0xAAB7, 0xAAB8, 1},
+		{0xAABE, 0xAABF, 1},
+		{0xAAC1, 0xAAC1, 1},
+		{0xAAEC, 0xAAED, 1},
+		{0xAAF6, 0xABE5, 239},
+		{0xABE8, 0xABED, 5},
+		{0xFB1E, 0xFB1E, 1},
+		{0xFE00, 0xFE0F, 1},
+		{0xFE20, 0xFE2F, 1},
+		{0xFEFF, 0xFEFF, 1},
+		{0xFFF9, 0xFFFB, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101FD, 0x102E0, 227},
+		{0x10376, 0x1037A, 1},
+		{0x10A01, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A0F, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A3F, 1},
+		{0x10AE5, 0x10AE6, 1},
+		{0x10D24, 0x10D27, 1},
+		{0x10EAB, 0x10EAC, 1},
+		{0x10EFD, 0x10EFF, 1},
+		{0x10F46, 0x10F50, 1},
+		{0x10F82, 0x10F85, 1},
+		{0x11001, 0x11001, 1},
+		{0x11038, 0x11046, 1},
+		{0x11070, 0x11070, 1},
+		{0x11073, 0x11074, 1},
+		{0x1107F, 0x11081, 1},
+		{0x110B3, 0x110B6, 1},
+		{0x110B9, 0x110BA, 1},
+		{0x110BD, 0x110C2, 5},
+		{0x110CD, 0x110CD, 1},
+		{0x11100, 0x11102, 1},
+		{0x11127, 0x1112B, 1},
+		{0x1112D, 0x11134, 1},
+		{0x11173, 0x11173, 1},
+		{0x11180, 0x11181, 1},
+		{0x111B6, 0x111BE, 1},
+		{0x111C9, 0x111CC, 1},
+		{0x111CF, 0x111CF, 1},
+		{0x1122F, 0x11231, 1},
+		{0x11234, 0x11234, 1},
+		{0x11236, 0x11237, 1},
+		{0x1123E, 0x11241, 3},
+		{0x112DF, 0x112DF, 1},
+		{0x112E3, 0x112EA, 1},
+		{0x11300, 0x11301, 1},
+		{0x1133B, 0x1133C, 1},
+		{0x11340, 0x11340, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+		{0x11438, 0x1143F, 1},
+		{0x11442, 0x11444, 1},
+		{0x11446, 0x1145E, 24},
+		{0x114B3, 0x114B8, 1},
+		{0x114BA, 0x114BA, 1},
+		{0x114BF, 0x114C0, 1},
+		{0x114C2, 0x114C3, 1},
+		{0x115B2, 0x115B5, 1},
+		{0x115BC, 0x115BD, 1},
+		{0x115BF, 0x115C0, 1},
+		{0x115DC, 0x115DD, 1},
+		{0x11633, 0x1163A, 1},
+		{0x1163D, 0x1163D, 1},
+		{0x1163F, 0x11640, 1},
+		{0x116AB, 0x116AD, 2},
+		{0x116B0, 0x116B5, 1},
+		{0x116B7, 0x116B7, 1},
+		{0x1171D, 0x1171F, 1},
+		{0x11722, 0x11725, 1},
+		{0x11727, 0x1172B, 1},
+		{0x1182F, 0x11837, 1},
+		{0x11839, 0x1183A, 1},
+		{0x1193B, 0x1193C, 1},
+		{0x1193E, 0x11943, 5},
+		{0x119D4, 0x119D7, 1},
+		{0x119DA, 0x119DB, 1},
+		{0x119E0, 0x119E0, 1},
+		{0x11A01, 0x11A0A, 1},
+		{0x11A33, 0x11A38, 1},
+		{0x11A3B, 0x11A3E, 1},
+		{0x11A47, 0x11A47, 1},
+		{0x11A51, 0x11A56, 1},
+		{0x11A59, 0x11A5B, 1},
+		{0x11A8A, 0x11A96, 1},
+		{0x11A98, 0x11A99, 1},
+		{0x11C30, 0x11C36, 1},
+		{0x11C38, 0x11C3D, 1},
+		{0x11C3F, 0x11C3F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CAA, 0x11CB0, 1},
+		{0x11CB2, 0x11CB3, 1},
+		{0x11CB5, 0x11CB6, 1},
+		{0x11D31, 0x11D36, 1},
+		{0x11D3A, 0x11D3A, 1},
+		{0x11D3C, 0x11D3D, 1},
+		{0x11D3F, 0x11D45, 1},
+		{0x11D47, 0x11D47, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D95, 0x11D97, 2},
+		{0x11EF3, 0x11EF4, 1},
+		{0x11F00, 0x11F01, 1},
+		{0x11F36, 0x11F3A, 1},
+		{0x11F40, 0x11F42, 2},
+		{0x13430, 0x13440, 1},
+		{0x13447, 0x13455, 1},
+		{0x16AF0, 0x16AF4, 1},
+		{0x16B30, 0x16B36, 1},
+		{0x16F4F, 0x16F4F, 1},
+		{0x16F8F, 0x16F92, 1},
+		{0x16FE4, 0x16FE4, 1},
+		{0x1BC9D, 0x1BC9E, 1},
+		{0x1BCA0, 0x1BCA3, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D167, 0x1D169, 1},
+		{0x1D173, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+		{0x1D242, 0x1D244, 1},
+		{0x1DA00, 0x1DA36, 1},
+		{0x1DA3B, 0x1DA6C, 1},
+		{0x1DA75, 0x1DA84, 15},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+		{0x1E08F, 0x1E08F, 1},
+		{0x1E130, 0x1E136, 1},
+		{0x1E2AE, 0x1E2AE, 1},
+		{0x1E2EC, 0x1E2EF, 1},
+		{0x1E4EC, 0x1E4EF, 1},
+		{0x1E8D0, 0x1E8D6, 1},
+		{0x1E944, 0x1E94A, 1},
+		{0xE0001, 0xE0001, 1},
+		{0xE0020, 0xE007F, 1},
+		{0xE0100, 0xE01EF, 1},
+	},
+	LatinOffset: 1,
+}
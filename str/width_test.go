@@ -0,0 +1,106 @@
+package str
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+
+	cases := []struct {
+		want int
+		s    string
+	}{
+		{5, "Hello"},
+		{4, "世界"},
+		{4, "café"},
+		{4, "café"},
+		{2, "💩"},
+		{2, "👩‍👩‍👧"},
+		{2, "🇳🇿"},
+		{2, "❤️"},
+		{1, "❤"},
+		{6, "ｈｉ！"},
+		{2, "각"},
+		{2, "가"},
+		{2, "a\tb\n"},
+		{0, "​"},
+		{0, ""},
+	}
+
+	for _, c := range cases {
+		if got := Width(c.s); got != c.want {
+			t.Errorf("Width(%q) return %d, wanted %d.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestPadLeftWidth(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		pad  rune
+		s    string
+		want string
+	}{
+		{5, ' ', "Hello", "Hello"},
+		{10, ' ', "Hello", "     Hello"},
+		{-1, ' ', "Hello", "Hello"},
+		{6, ' ', "世界", "  世界"},
+		{3, ' ', "世界", "世界"},
+		{5, ' ', "💩💩", " 💩💩"},
+		{5, '世', "hi", " 世hi"},
+		{5, '́', "hi", "hi"},
+	}
+
+	for _, c := range cases {
+		if got := PadLeftWidth(c.s, c.pad, c.n); got != c.want {
+			t.Errorf("PadLeftWidth(%q, %q, %d) return %q, wanted %q.", c.s, c.pad, c.n, got, c.want)
+		}
+	}
+}
+
+func TestPadRightWidth(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		pad  rune
+		s    string
+		want string
+	}{
+		{5, ' ', "Hello", "Hello"},
+		{10, ' ', "Hello", "Hello     "},
+		{6, ' ', "世界", "世界  "},
+		{6, '-', "café", "café--"},
+		{6, '世', "hi", "hi世世"},
+	}
+
+	for _, c := range cases {
+		if got := PadRightWidth(c.s, c.pad, c.n); got != c.want {
+			t.Errorf("PadRightWidth(%q, %q, %d) return %q, wanted %q.", c.s, c.pad, c.n, got, c.want)
+		}
+	}
+}
+
+func TestPadToWidest(t *testing.T) {
+
+	ss := []string{
+		"hi",
+		"世界",
+		"💩💩💩",
+		"café",
+		"",
+	}
+	want := []string{
+		"hi    ",
+		"世界  ",
+		"💩💩💩",
+		"café  ",
+		"      ",
+	}
+
+	if got := PadToWidest(ss, ' '); !strSliceEqual(got, want) {
+		t.Errorf(
+			"PadToWidest(ss, ' ')\n"+
+				"    return %q\n"+
+				"    wanted %q.",
+			got, want)
+	}
+}
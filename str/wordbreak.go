@@ -0,0 +1,245 @@
+package str
+
+import "unicode"
+
+/*
+UnicodeWords is an alternative to Words that finds word boundaries
+using the default word boundary rules in Unicode Standard Annex #29
+rather than a fixed set of grammatical marks. It returns the words
+in s as a slice of strings in order of their appearance.
+
+Segments consisting only of spaces or punctuation, such as curly
+quotes, guillemets or dashes, are omitted. Punctuation within a
+word is kept where UAX #29 keeps it, such as apostrophes in
+contractions, the decimal point in numbers, and underscores.
+
+	ww := UnicodeWords(`«Voilà», she said—it’s 3.5 km`)
+	// ww is []string{"Voilà", "she", "said", "it’s", "3.5", "km"}
+
+Scripts that are written without spaces, such as Chinese, Japanese
+and Thai, require dictionary based segmentation which is beyond the
+scope of UAX #29's default rules. Ideographs and letters of these
+scripts are returned one per word, though runs of Katakana are kept
+together.
+
+If s contains no words the slice will be non-nil and zero length.
+*/
+func UnicodeWords(s string) []string {
+	words := make([]string, 0, len(s)/6)
+	forEachWordSegment(s, func(seg string) {
+		if isWordSegment(seg) {
+			words = append(words, seg)
+		}
+	})
+	return words
+}
+
+/*
+UnicodeWordCount returns the number of words in s.
+
+See UnicodeWords for what a word is in this context.
+*/
+func UnicodeWordCount(s string) int {
+	var count int
+	forEachWordSegment(s, func(seg string) {
+		if isWordSegment(seg) {
+			count++
+		}
+	})
+	return count
+}
+
+/*
+UnicodeWordSet is the same as UnicodeWords but removes duplicates
+from its results. Words will appear in order of their first
+appearance in s. The fold parameter behaves as it does for WordSet.
+
+See UnicodeWords for what a word is in this context.
+*/
+func UnicodeWordSet(s string, fold bool) []string {
+	return makeSet(UnicodeWords(s), fold)
+}
+
+/*
+UnicodeWordsByOccurrence is the same as WordsByOccurrence except
+that words are found using the rules of UnicodeWords.
+*/
+func UnicodeWordsByOccurrence(s string, fold bool) OccMap {
+	return occurrences(UnicodeWords(s), fold)
+}
+
+/*
+isWordSegment reports whether the segment seg, as produced by
+forEachWordSegment, contains a letter, number, pictograph or flag.
+*/
+func isWordSegment(seg string) bool {
+	for _, r := range seg {
+		if unicode.Is(wbLetterOrNumberTable, r) || isExtendedPictographic(r) {
+			return true
+		}
+		if gcbOf(r) == gcbRegionalIndicator {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+forEachWordSegment calls fn with each segment of s between word
+boundaries as defined by UAX #29. Every byte of s belongs to exactly
+one segment, so segments include spaces and punctuation too.
+*/
+func forEachWordSegment(s string, fn func(seg string)) {
+
+	if s == "" {
+		return
+	}
+
+	rr := make([]wbRune, 0, len(s))
+	for i, r := range s {
+		rr = append(rr, wbRune{wbOf(r), isExtendedPictographic(r), i})
+	}
+
+	start := 0
+	for i := 1; i < len(rr); i++ {
+		if wordBoundary(rr, i) {
+			fn(s[start:rr[i].pos])
+			start = rr[i].pos
+		}
+	}
+	fn(s[start:])
+}
+
+type wbRune struct {
+	prop wbProp
+	pict bool
+	pos  int
+}
+
+func wbIgnorable(p wbProp) bool {
+	return p == wbExtend || p == wbFormat || p == wbZWJ
+}
+
+func wbAHLetter(p wbProp) bool {
+	return p == wbALetter || p == wbHebrewLetter
+}
+
+func wbMidNumLetQ(p wbProp) bool {
+	return p == wbMidNumLet || p == wbSingleQuote
+}
+
+/*
+wordBoundary reports whether there is a word boundary between
+rr[i-1] and rr[i]. The rule numbers refer to those in UAX #29.
+*/
+func wordBoundary(rr []wbRune, i int) bool {
+
+	prev, next := rr[i-1].prop, rr[i].prop
+
+	switch {
+	case prev == wbCR && next == wbLF: // WB3
+		return false
+	case prev == wbCR, prev == wbLF, prev == wbNewline: // WB3a
+		return true
+	case next == wbCR, next == wbLF, next == wbNewline: // WB3b
+		return true
+	case prev == wbZWJ && rr[i].pict: // WB3c
+		return false
+	case prev == wbWSegSpace && next == wbWSegSpace: // WB3d
+		return false
+	case wbIgnorable(next): // WB4
+		return false
+	}
+
+	// WB4: ignore Extend, Format and ZWJ when looking
+	// at the characters either side of the boundary.
+	p1 := wbSkipBack(rr, i-1)
+	if p1 < 0 {
+		return true
+	}
+	prev = rr[p1].prop
+
+	var prev2, next2 wbProp = wbOther, wbOther
+	if p2 := wbSkipBack(rr, p1-1); p2 >= 0 {
+		prev2 = rr[p2].prop
+	}
+	if n2 := wbSkipForward(rr, i+1); n2 < len(rr) {
+		next2 = rr[n2].prop
+	}
+
+	switch {
+	case wbAHLetter(prev) && wbAHLetter(next): // WB5
+		return false
+	case wbAHLetter(prev) && (next == wbMidLetter || wbMidNumLetQ(next)) && wbAHLetter(next2): // WB6
+		return false
+	case wbAHLetter(prev2) && (prev == wbMidLetter || wbMidNumLetQ(prev)) && wbAHLetter(next): // WB7
+		return false
+	case prev == wbHebrewLetter && next == wbSingleQuote: // WB7a
+		return false
+	case prev == wbHebrewLetter && next == wbDoubleQuote && next2 == wbHebrewLetter: // WB7b
+		return false
+	case prev2 == wbHebrewLetter && prev == wbDoubleQuote && next == wbHebrewLetter: // WB7c
+		return false
+	case prev == wbNumeric && next == wbNumeric: // WB8
+		return false
+	case wbAHLetter(prev) && next == wbNumeric: // WB9
+		return false
+	case prev == wbNumeric && wbAHLetter(next): // WB10
+		return false
+	case prev2 == wbNumeric && (prev == wbMidNum || wbMidNumLetQ(prev)) && next == wbNumeric: // WB11
+		return false
+	case prev == wbNumeric && (next == wbMidNum || wbMidNumLetQ(next)) && next2 == wbNumeric: // WB12
+		return false
+	case prev == wbKatakana && next == wbKatakana: // WB13
+		return false
+	case next == wbExtendNumLet && (wbAHLetter(prev) || prev == wbNumeric || prev == wbKatakana || prev == wbExtendNumLet): // WB13a
+		return false
+	case prev == wbExtendNumLet && (wbAHLetter(next) || next == wbNumeric || next == wbKatakana): // WB13b
+		return false
+	case prev == wbRegionalIndicator && next == wbRegionalIndicator: // WB15, WB16
+		var n int
+		for j := p1; j >= 0; j = wbSkipBack(rr, j-1) {
+			if rr[j].prop != wbRegionalIndicator {
+				break
+			}
+			n++
+		}
+		return n%2 == 0
+	}
+
+	return true // WB999
+}
+
+/*
+wbSkipBack returns the index of the last rune at or before i that
+is not ignorable under rule WB4, or -1 if there isn't one. Ignorable
+runes directly after a line break are not skipped over since WB4
+does not apply to them.
+*/
+func wbSkipBack(rr []wbRune, i int) int {
+	for ; i >= 0; i-- {
+		if !wbIgnorable(rr[i].prop) {
+			return i
+		}
+		if i > 0 {
+			switch rr[i-1].prop {
+			case wbCR, wbLF, wbNewline:
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+/*
+wbSkipForward returns the index of the first rune at or after i that
+is not ignorable under rule WB4, or len(rr) if there isn't one.
+*/
+func wbSkipForward(rr []wbRune, i int) int {
+	for ; i < len(rr); i++ {
+		if !wbIgnorable(rr[i].prop) {
+			return i
+		}
+	}
+	return len(rr)
+}
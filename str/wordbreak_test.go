@@ -0,0 +1,106 @@
+package str
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestUnicodeWords(t *testing.T) {
+
+	cases := []struct {
+		s    string
+		want []string
+	}{
+		{"grammar at end,,)", []string{"grammar", "at", "end"}},
+		{"    Status: happy", []string{"Status", "happy"}},
+		{"either/or", []string{"either", "or"}},
+		{`"here's an em—dash"`, []string{"here's", "an", "em", "dash"}},
+		{"“curly quotes” and ‘single’ ones", []string{"curly", "quotes", "and", "single", "ones"}},
+		{"«Voilà», she said—it’s 3.5 km", []string{"Voilà", "she", "said", "it’s", "3.5", "km"}},
+		{"a‐b ‒ c ― d", []string{"a", "b", "c", "d"}},
+		{"snake_case and 1,000.5", []string{"snake_case", "and", "1,000.5"}},
+		{"e.g. U.S.A.", []string{"e.g", "U.S.A"}},
+		{"¿Qué? ¡Sí!", []string{"Qué", "Sí"}},
+		{"Привет, мир!", []string{"Привет", "мир"}},
+		{"世界 世界", []string{"世", "界", "世", "界"}},
+		{"カタカナ です", []string{"カタカナ", "で", "す"}},
+		{"hello I am poop 💩 hi", []string{"hello", "I", "am", "poop", "💩", "hi"}},
+		{"👩‍👩‍👧 🇳🇿🇯🇵", []string{"👩‍👩‍👧", "🇳🇿", "🇯🇵"}},
+		{"café", []string{"café"}},
+		{"ﬁ\u0e33", []string{"ﬁ", "\u0e33"}},
+		{"မြန်မာစာ", []string{"မြ", "န်", "မာ", "စာ"}},
+		{"ᨠᩣᨠᩡ", []string{"ᨠᩣ", "ᨠᩡ"}},
+		{"", []string{}},
+		{"\n\n\n  \n\n\n", []string{}},
+		{"— … —", []string{}},
+	}
+
+	for _, c := range cases {
+		if got := UnicodeWords(c.s); !strSliceEqual(got, c.want) {
+			t.Errorf("UnicodeWords(%q) return %q, wanted %q.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestUnicodeWordCount(t *testing.T) {
+
+	cases := []struct {
+		want int
+		s    string
+	}{
+		{4, `"here's a forward/slash!"`},
+		{6, "«Voilà», she said—it’s 3.5 km"},
+		{3, "Hello there, friend!"},
+		{0, ""},
+		{0, "\n\n\n  \n\n\n"},
+	}
+
+	for _, c := range cases {
+		wordsLen := len(UnicodeWords(c.s))
+		got := UnicodeWordCount(c.s)
+		if got != wordsLen {
+			t.Errorf("UnicodeWordCount(%q) return %d, len(UnicodeWords(%q)) is %d.", c.s, got, c.s, wordsLen)
+		}
+		if got != c.want {
+			t.Errorf("UnicodeWordCount(%q) return %d, wanted %d.", c.s, got, c.want)
+		}
+	}
+}
+
+func TestUnicodeWordSet(t *testing.T) {
+
+	cases := []struct {
+		fold bool
+		s    string
+		want []string
+	}{
+		{false, "“Really”, Really, really… tired.", []string{"Really", "really", "tired"}},
+		{true, "“Really”, Really, really… tired.", []string{"really", "tired"}},
+	}
+
+	for _, c := range cases {
+		if got := UnicodeWordSet(c.s, c.fold); !strSliceEqual(got, c.want) {
+			t.Errorf("UnicodeWordSet(%q, %v) return %v, wanted %v.", c.s, c.fold, got, c.want)
+		}
+	}
+}
+
+func TestUnicodeWordsByOccurrence(t *testing.T) {
+
+	s := "«Thing», “thing” and THING—thing’s"
+	want := OccMap{
+		{SubStr: "thing", N: 3},
+		{SubStr: "and", N: 1},
+		{SubStr: "thing’s", N: 1},
+	}
+
+	got := UnicodeWordsByOccurrence(s, true)
+	sort.Sort(got)
+	if !occSliceCorrect(got, want) {
+		t.Errorf(
+			"UnicodeWordsByOccurrence(%q)\n"+
+				"    return %v\n"+
+				"    wanted %v",
+			s, got, want)
+	}
+}
@@ -0,0 +1,326 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Unit is the unit in which the length of a string is measured when
+laying out text.
+*/
+type Unit int
+
+const (
+	// ByRunes counts runes, as Len does.
+	ByRunes Unit = iota
+
+	// ByGraphemes counts grapheme clusters, as GraphemeLen
+	// does.
+	ByGraphemes
+
+	// ByWidth counts the columns the string occupies in a
+	// monospace terminal, as Width does.
+	ByWidth
+)
+
+// measure returns the length of s in u.
+func (u Unit) measure(s string) int {
+	switch u {
+	case ByGraphemes:
+		return GraphemeLen(s)
+	case ByWidth:
+		return Width(s)
+	}
+	return utf8.RuneCountInString(s)
+}
+
+/*
+WrapOptions configures Wrap. The zero value wraps by runes at the
+boundaries Words uses and doesn't indent.
+*/
+type WrapOptions struct {
+	// Unit is the unit in which line lengths are measured.
+	Unit Unit
+
+	// Prefix begins every line, before any indent. It is
+	// useful for quoting, as in "> ", or comments, as in "// ".
+	Prefix string
+
+	// FirstIndent begins the first line of each paragraph.
+	FirstIndent string
+
+	// HangingIndent begins every other line of a paragraph.
+	HangingIndent string
+
+	// LineBreaks breaks lines at the line break opportunities
+	// of Unicode Standard Annex #14, in a simplified form,
+	// rather than at the boundaries Words uses. This allows
+	// lines to break after hyphens and between ideographs in
+	// text without spaces, such as Chinese and Japanese.
+	LineBreaks bool
+
+	// BreakWords breaks words that are too long to fit on a
+	// line by themselves between grapheme clusters. Otherwise
+	// such words are placed on a line of their own which is
+	// longer than the width.
+	BreakWords bool
+}
+
+/*
+Wrap returns s with line breaks inserted so that no line is longer
+than width, measured in opts.Unit and including any prefix and indent.
+Lines are broken at the boundaries Words uses: after spaces and after
+the other boundary characters such as slashes and dashes. Grapheme
+clusters are never split. Spaces at the point of a break are removed.
+
+Existing line breaks in s are kept and each line of s is wrapped as a
+separate paragraph. A width less than 1 leaves lines unwrapped but
+still applies opts.Prefix and indents.
+
+	s := str.Wrap("The quick brown fox jumps over the lazy dog.", 16, str.WrapOptions{
+		HangingIndent: "  ",
+	})
+	// s is "The quick brown\n  fox jumps over\n  the lazy dog."
+*/
+func Wrap(s string, width int, opts WrapOptions) string {
+	return strings.Join(WrapLines(s, width, opts), "\n")
+}
+
+/*
+WrapLines is the same as Wrap except that it returns the lines
+without joining them. Its result may be passed to JustifyWrapped.
+*/
+func WrapLines(s string, width int, opts WrapOptions) []string {
+	var lines []string
+	for _, para := range strings.Split(s, "\n") {
+		lines = opts.wrapParagraph(lines, strings.TrimSuffix(para, "\r"), width)
+	}
+	return lines
+}
+
+/*
+wrapParagraph appends the lines of the wrapped paragraph para to
+lines and returns the result.
+*/
+func (opts WrapOptions) wrapParagraph(lines []string, para string, width int) []string {
+
+	u := opts.Unit
+	indent := opts.FirstIndent
+
+	var line strings.Builder
+	var lineLen int
+
+	// pending holds the spaces that followed the last piece
+	// of text on the line, which are only written if more
+	// text follows them.
+	var pending string
+
+	emit := func() {
+		start := opts.Prefix + indent
+		if line.Len() == 0 {
+			start = strings.TrimRightFunc(start, unicode.IsSpace)
+		}
+		lines = append(lines, start+line.String())
+		line.Reset()
+		lineLen = 0
+		pending = ""
+		indent = opts.HangingIndent
+	}
+
+	avail := func() int {
+		return max(width-u.measure(opts.Prefix+indent), 1)
+	}
+
+	for _, seg := range wrapSegments(para, opts.LineBreaks) {
+
+		text := strings.TrimRightFunc(seg, unicode.IsSpace)
+		space := seg[len(text):]
+		n := u.measure(text)
+
+		if line.Len() > 0 {
+			sp := u.measure(pending)
+			if width < 1 || lineLen+sp+n <= avail() {
+				line.WriteString(pending + text)
+				lineLen += sp + n
+				pending = space
+				continue
+			}
+			emit()
+		}
+
+		if opts.BreakWords && width > 0 && n > avail() {
+			chunks := breakWord(text, avail(), u)
+			for _, c := range chunks[:len(chunks)-1] {
+				line.WriteString(c)
+				emit()
+			}
+			text = chunks[len(chunks)-1]
+			n = u.measure(text)
+		}
+
+		line.WriteString(text)
+		lineLen = n
+		pending = space
+	}
+
+	emit()
+	return lines
+}
+
+/*
+wrapSegments splits s into the pieces between which a line may be
+broken. Each piece ends with any spaces that follow it.
+*/
+func wrapSegments(s string, lineBreaks bool) []string {
+
+	var segs []string
+	var prev string
+	start := 0
+
+	// Leading spaces stay with the first piece so that they
+	// indent the line.
+	leading := true
+
+	for i := 0; i < len(s); {
+		n := nextGrapheme(s[i:])
+		g := s[i : i+n]
+		if !leading && wrapBreak(prev, g, lineBreaks) {
+			segs = append(segs, s[start:i])
+			start = i
+		}
+		if strings.TrimSpace(g) != "" {
+			leading = false
+		}
+		prev = g
+		i += n
+	}
+
+	if start < len(s) {
+		segs = append(segs, s[start:])
+	}
+	return segs
+}
+
+/*
+wrapBreak reports whether a line may be broken between the grapheme
+clusters a and b.
+*/
+func wrapBreak(a, b string, lineBreaks bool) bool {
+
+	ra, _ := utf8.DecodeLastRuneInString(a)
+	rb, _ := utf8.DecodeRuneInString(b)
+
+	if !lineBreaks {
+		if defaultTokenizer.isBoundary(rb) {
+			return false
+		}
+		return defaultTokenizer.isBoundary(ra)
+	}
+
+	ca, cb := lineBreakClass(ra), lineBreakClass(rb)
+	switch {
+	case ca == lbGlue || cb == lbGlue:
+		return false
+	case cb == lbSpace || cb == lbClose:
+		return false
+	case ca == lbOpen:
+		return false
+	case ca == lbSpace:
+		return true
+	case ca == lbDash || cb == lbDash:
+		return ca != cb
+	case ca == lbHyphen:
+		return !unicode.IsDigit(rb)
+	case ca == lbIdeographic || cb == lbIdeographic:
+		return true
+	}
+	return false
+}
+
+/*
+lbClass is a simplified Unicode line breaking class, as used by
+wrapBreak.
+*/
+type lbClass uint8
+
+const (
+	lbOther lbClass = iota
+
+	// lbSpace allows a break after it.
+	lbSpace
+
+	// lbGlue prevents breaks either side of it.
+	lbGlue
+
+	// lbOpen prevents a break after it.
+	lbOpen
+
+	// lbClose prevents a break before it.
+	lbClose
+
+	// lbHyphen allows a break after it, except before a digit.
+	lbHyphen
+
+	// lbDash allows a break before and after it.
+	lbDash
+
+	// lbIdeographic allows a break before and after it.
+	lbIdeographic
+)
+
+const (
+	lbOpenRunes  = "([{«‹“‘¿¡「『【〔〈《〘〖（［｛"
+	lbCloseRunes = ".,:;!?)]}»›”’、。，．：；！？」』】〕〉》〙〗）］｝" +
+		"ぁぃぅぇぉっゃゅょゎゕゖァィゥェォッャュョヮヵヶーヽヾゝゞ々〻"
+	lbHyphenRunes = "-/\u00ad\u2010\u2012\u2013"
+	lbGlueRunes   = "\u00a0\u202f\u2011\u2060\ufeff"
+)
+
+func lineBreakClass(r rune) lbClass {
+	switch {
+	case strings.ContainsRune(lbGlueRunes, r):
+		return lbGlue
+	case unicode.IsSpace(r), r == 0x200B:
+		return lbSpace
+	case strings.ContainsRune(lbOpenRunes, r):
+		return lbOpen
+	case strings.ContainsRune(lbCloseRunes, r):
+		return lbClose
+	case strings.ContainsRune(lbHyphenRunes, r):
+		return lbHyphen
+	case r == '—':
+		return lbDash
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana),
+		r >= hangulBase && r <= hangulLast:
+		return lbIdeographic
+	}
+	return lbOther
+}
+
+/*
+breakWord splits s between grapheme clusters into pieces no longer
+than limit measured in u. A grapheme cluster longer than limit by
+itself forms its own piece.
+*/
+func breakWord(s string, limit int, u Unit) []string {
+
+	var pieces []string
+	var n int
+	start := 0
+
+	for i := 0; i < len(s); {
+		size := nextGrapheme(s[i:])
+		gn := u.measure(s[i : i+size])
+		if n+gn > limit && i > start {
+			pieces = append(pieces, s[start:i])
+			start = i
+			n = 0
+		}
+		n += gn
+		i += size
+	}
+
+	return append(pieces, s[start:])
+}
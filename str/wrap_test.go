@@ -0,0 +1,78 @@
+package str
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+
+	fox := "The quick brown fox jumps over the lazy dog."
+
+	cases := []struct {
+		s     string
+		width int
+		opts  WrapOptions
+		want  string
+	}{
+		{fox, 16, WrapOptions{}, "The quick brown\nfox jumps over\nthe lazy dog."},
+		{fox, 15, WrapOptions{}, "The quick brown\nfox jumps over\nthe lazy dog."},
+		{fox, 14, WrapOptions{}, "The quick\nbrown fox\njumps over the\nlazy dog."},
+		{fox, 100, WrapOptions{}, fox},
+		{fox, 0, WrapOptions{}, fox},
+
+		// Indents and prefixes count towards the width.
+		{fox, 16, WrapOptions{HangingIndent: "  "}, "The quick brown\n  fox jumps over\n  the lazy dog."},
+		{fox, 16, WrapOptions{FirstIndent: "    "}, "    The quick\nbrown fox jumps\nover the lazy\ndog."},
+		{fox, 18, WrapOptions{Prefix: "> "}, "> The quick brown\n> fox jumps over\n> the lazy dog."},
+		{"-v  Print more\n\n-q  Print less", 12, WrapOptions{Prefix: "// ", HangingIndent: "    "},
+			"// -v  Print\n//     more\n//\n// -q  Print\n//     less"},
+
+		// Extra spaces within a line are kept but those at a
+		// break are removed.
+		{"one  two   three", 9, WrapOptions{}, "one  two\nthree"},
+		{"  indented text", 10, WrapOptions{}, "  indented\ntext"},
+
+		// Boundaries other than spaces.
+		{"narrator/programmer", 10, WrapOptions{}, "narrator/\nprogrammer"},
+		{"stop—e-mail me", 8, WrapOptions{}, "stop—\ne-mail\nme"},
+
+		// Long words.
+		{"a supercalifragilistic word", 8, WrapOptions{}, "a\nsupercalifragilistic\nword"},
+		{"a supercalifragilistic word", 8, WrapOptions{BreakWords: true}, "a\nsupercal\nifragili\nstic\nword"},
+
+		// Grapheme clusters are never split.
+		{"cafe\u0301cafe\u0301", 3, WrapOptions{BreakWords: true}, "caf\ne\u0301c\naf\ne\u0301"},
+		{"cafe\u0301cafe\u0301", 3, WrapOptions{Unit: ByGraphemes, BreakWords: true}, "caf\ne\u0301ca\nfe\u0301"},
+		{"🇳🇿🇯🇵🇫🇷", 3, WrapOptions{Unit: ByWidth, BreakWords: true}, "🇳🇿\n🇯🇵\n🇫🇷"},
+
+		// Display width.
+		{"世界 世界 世界", 9, WrapOptions{Unit: ByWidth}, "世界 世界\n世界"},
+		{"世界 世界 世界", 9, WrapOptions{}, "世界 世界 世界"},
+
+		// Line break opportunities.
+		{"well-known fact", 6, WrapOptions{}, "well-known\nfact"},
+		{"well-known fact", 6, WrapOptions{LineBreaks: true}, "well-\nknown\nfact"},
+		{"pay -5 now", 4, WrapOptions{LineBreaks: true}, "pay\n-5\nnow"},
+		{"日本語のテキスト", 6, WrapOptions{Unit: ByWidth, LineBreaks: true}, "日本語\nのテキ\nスト"},
+		{"「日本」です。", 4, WrapOptions{Unit: ByWidth, LineBreaks: true}, "「日\n本」\nで\nす。"},
+		{"10 km away", 5, WrapOptions{LineBreaks: true}, "10 km\naway"},
+		{"see (this) here", 4, WrapOptions{LineBreaks: true}, "see\n(this)\nhere"},
+
+		{"", 10, WrapOptions{}, ""},
+		{"", 10, WrapOptions{Prefix: "> "}, ">"},
+	}
+
+	for _, c := range cases {
+		if got := Wrap(c.s, c.width, c.opts); got != c.want {
+			t.Errorf("Wrap(%q, %d, %+v) return %q, wanted %q.", c.s, c.width, c.opts, got, c.want)
+		}
+	}
+}
+
+func TestWrapLines(t *testing.T) {
+
+	s := "one two\r\nthree"
+	want := []string{"one", "two", "three"}
+
+	if got := WrapLines(s, 3, WrapOptions{}); !strSliceEqual(got, want) {
+		t.Errorf("WrapLines(%q, 3, {}) return %s, wanted %s.", s, quoteSlice(got), quoteSlice(want))
+	}
+}